@@ -0,0 +1,599 @@
+package chrono
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CalendarEvent is a systemd calendar event expression, as used by the OnCalendar= setting of systemd timers,
+// which specifies the times at which a timer elapses.
+//
+// An expression is of the form "[Weekdays] [Year-Month-Day] [Hour:Minute[:Second]] [Timezone]", such as
+// "Mon..Fri *-*-* 09:00:00" or "*-*-01 00:00:00 Europe/Berlin". The weekdays are a comma-separated list of
+// names (Mon or Monday) and ranges (Mon..Fri or Mon-Fri), which may be followed by a comma, as in "Wed, 17:48",
+// and the time zone is UTC or a name in the IANA Time Zone Database.
+// Each component of the date and time is one of *, a value, a range a..b, or a repetition */n, a/n or a..b/n,
+// or a comma-separated list of any of these. A two-digit year is in the range 1970 to 2069.
+// The values and repetitions of the seconds may have a fraction, which is rounded to 6 digits, such as 05:40:23.42/3.17.
+// If the date is omitted, it is *-*-*, if the year is omitted, it is *, if the time is omitted, it is 00:00:00,
+// and if the seconds are omitted, they are 00. The date may use ~ in place of the last -, in which case the day
+// is counted back from the last day of the month, such that *-02~01 is the last day of February,
+// and Mon *-05~07/1 is the last Monday of May. A time point matches if it matches both the weekdays and the date.
+//
+// The following special expressions are also supported: minutely, hourly, daily, weekly, monthly, quarterly,
+// semiannually, and yearly (or annually).
+type CalendarEvent struct {
+	weekdays                [8]bool
+	anyWeekday              bool
+	years, months, days     []calendarComponent
+	hours, minutes, seconds []calendarComponent
+	endOfMonth              bool
+	zone                    string
+	offsets                 OffsetFunc
+}
+
+// calendarComponent is an item of a component of a calendar event, where stop is -1 if it is not a range,
+// and repeat is 0 if it does not repeat.
+type calendarComponent struct {
+	start, stop, repeat int
+}
+
+const (
+	calendarMinYear = 1970
+	calendarMaxYear = 2199
+
+	// calendarSecondMicros is the number of microseconds in a second. The seconds of a calendar event are stored in microseconds.
+	calendarSecondMicros = 1000000
+)
+
+// calendarValueKind is the kind of the values of a component of a calendar event.
+type calendarValueKind int
+
+const (
+	calendarNumber  calendarValueKind = iota
+	calendarYear                      // a year, which may have two digits
+	calendarSeconds                   // seconds, which may have a fraction and are stored in microseconds
+)
+
+var calendarEventSpecials = map[string]string{
+	"minutely":     "*-*-* *:*:00",
+	"hourly":       "*-*-* *:00:00",
+	"daily":        "*-*-* 00:00:00",
+	"weekly":       "Mon *-*-* 00:00:00",
+	"monthly":      "*-*-01 00:00:00",
+	"quarterly":    "*-01,04,07,10-01 00:00:00",
+	"semiannually": "*-01,07-01 00:00:00",
+	"yearly":       "*-01-01 00:00:00",
+	"annually":     "*-01-01 00:00:00",
+}
+
+var calendarWeekdayNames = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// ParseCalendarEvent parses a systemd calendar event expression, as described by [CalendarEvent].
+func ParseCalendarEvent(s string) (CalendarEvent, error) {
+	out := CalendarEvent{anyWeekday: true}
+
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return CalendarEvent{}, fmt.Errorf("parsing calendar event: empty expression")
+	}
+
+	if n := len(fields); n > 1 && !isCalendarDate(fields[n-1]) && !strings.Contains(fields[n-1], ":") {
+		if err := out.setZone(fields[n-1]); err != nil {
+			return CalendarEvent{}, fmt.Errorf("parsing calendar event: %v", err)
+		}
+		fields = fields[:n-1]
+	}
+
+	if len(fields) == 1 {
+		if special, ok := calendarEventSpecials[strings.ToLower(fields[0])]; ok {
+			fields = strings.Fields(special)
+		}
+	}
+
+	if !isCalendarDate(fields[0]) && !strings.Contains(fields[0], ":") {
+		if err := out.parseWeekdays(fields[0]); err != nil {
+			return CalendarEvent{}, fmt.Errorf("parsing calendar event: weekdays: %v", err)
+		}
+		fields = fields[1:]
+	}
+
+	date, tm := "*-*-*", "00:00:00"
+	if len(fields) > 0 && isCalendarDate(fields[0]) {
+		date, fields = fields[0], fields[1:]
+	}
+
+	if len(fields) > 0 && strings.Contains(fields[0], ":") {
+		tm, fields = fields[0], fields[1:]
+	}
+
+	if len(fields) > 0 {
+		return CalendarEvent{}, fmt.Errorf("parsing calendar event: unexpected %q", fields[0])
+	}
+
+	if err := out.parseDate(date); err != nil {
+		return CalendarEvent{}, fmt.Errorf("parsing calendar event: date: %v", err)
+	}
+
+	if err := out.parseTime(tm); err != nil {
+		return CalendarEvent{}, fmt.Errorf("parsing calendar event: time: %v", err)
+	}
+	return out, nil
+}
+
+// isCalendarDate reports whether s is the date of a calendar event, rather than its weekdays, time or time zone.
+func isCalendarDate(s string) bool {
+	return s != "" && (s[0] == '*' || (s[0] >= '0' && s[0] <= '9')) && strings.ContainsAny(s, "-~") && !strings.Contains(s, ":")
+}
+
+func (e *CalendarEvent) setZone(name string) error {
+	offsets, err := ZoneOffsets(name)
+	if err != nil {
+		return err
+	}
+	e.zone, e.offsets = name, offsets
+	return nil
+}
+
+func (e *CalendarEvent) parseWeekdays(s string) error {
+	e.anyWeekday = false
+	for _, item := range strings.Split(strings.TrimSuffix(s, ","), ",") {
+		from, to := item, item
+		if i := strings.Index(item, ".."); i > 0 {
+			from, to = item[:i], item[i+2:]
+		} else if i := strings.IndexByte(item, '-'); i > 0 {
+			from, to = item[:i], item[i+1:]
+		}
+
+		d1, err := parseCalendarWeekday(from)
+		if err != nil {
+			return err
+		}
+
+		d2, err := parseCalendarWeekday(to)
+		if err != nil {
+			return err
+		}
+
+		for d := d1; ; d = d%7 + 1 {
+			e.weekdays[d] = true
+			if d == d2 {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func parseCalendarWeekday(s string) (int, error) {
+	for i, name := range calendarWeekdayNames {
+		if strings.EqualFold(s, name) || strings.EqualFold(s, Weekday(i+1).String()) {
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", s)
+}
+
+func (e *CalendarEvent) parseDate(s string) error {
+	date := s
+	if i := strings.LastIndexByte(s, '~'); i >= 0 {
+		e.endOfMonth = true
+		date = s[:i] + "-" + s[i+1:]
+	}
+
+	parts := strings.Split(date, "-")
+	switch len(parts) {
+	case 2:
+		parts = append([]string{"*"}, parts...)
+	case 3:
+	default:
+		return fmt.Errorf("invalid date %q", s)
+	}
+
+	var err error
+	if e.years, err = parseCalendarComponent(parts[0], calendarMinYear, calendarMaxYear, calendarYear); err != nil {
+		return err
+	}
+
+	if e.months, err = parseCalendarComponent(parts[1], 1, 12, calendarNumber); err != nil {
+		return err
+	}
+
+	e.days, err = parseCalendarComponent(parts[2], 1, 31, calendarNumber)
+	return err
+}
+
+func (e *CalendarEvent) parseTime(s string) error {
+	parts := strings.Split(s, ":")
+	switch len(parts) {
+	case 2:
+		parts = append(parts, "00")
+	case 3:
+	default:
+		return fmt.Errorf("invalid time %q", s)
+	}
+
+	var err error
+	if e.hours, err = parseCalendarComponent(parts[0], 0, 23, calendarNumber); err != nil {
+		return err
+	}
+
+	if e.minutes, err = parseCalendarComponent(parts[1], 0, 59, calendarNumber); err != nil {
+		return err
+	}
+
+	e.seconds, err = parseCalendarComponent(parts[2], 0, 60*calendarSecondMicros-1, calendarSeconds)
+	return err
+}
+
+// parseCalendarComponent parses a component whose values are in the range lo to hi.
+// A nil slice is returned for *, and the items are otherwise sorted with duplicates removed.
+func parseCalendarComponent(s string, lo, hi int, kind calendarValueKind) ([]calendarComponent, error) {
+	if s == "*" {
+		return nil, nil
+	}
+
+	var out []calendarComponent
+	for _, item := range strings.Split(s, ",") {
+		c := calendarComponent{stop: -1}
+		if i := strings.IndexByte(item, '/'); i >= 0 {
+			var err error
+			if kind == calendarSeconds {
+				c.repeat, err = parseCalendarValue(item[i+1:], 1, math.MaxInt32, kind)
+			} else {
+				c.repeat, err = strconv.Atoi(item[i+1:])
+			}
+
+			if err != nil || c.repeat < 1 {
+				return nil, fmt.Errorf("invalid repetition %q", item[i+1:])
+			}
+			item = item[:i]
+		}
+
+		var err error
+		switch i := strings.Index(item, ".."); {
+		case item == "*":
+			if c.repeat == 0 {
+				return nil, fmt.Errorf("invalid value %q", s)
+			}
+			c.start = lo
+		case i > 0:
+			if c.start, err = parseCalendarValue(item[:i], lo, hi, kind); err != nil {
+				return nil, err
+			}
+
+			if c.stop, err = parseCalendarValue(item[i+2:], lo, hi, kind); err != nil {
+				return nil, err
+			} else if c.stop < c.start {
+				return nil, fmt.Errorf("invalid range %q", item)
+			}
+
+			if c.repeat == 0 {
+				c.repeat = 1
+				if kind == calendarSeconds {
+					c.repeat = calendarSecondMicros
+				}
+			}
+		default:
+			if c.start, err = parseCalendarValue(item, lo, hi, kind); err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, c)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.start != b.start {
+			return a.start < b.start
+		} else if a.stop != b.stop {
+			return a.stop < b.stop
+		}
+		return a.repeat < b.repeat
+	})
+
+	n := 0
+	for i, c := range out {
+		if i == 0 || c != out[n-1] {
+			out[n] = c
+			n++
+		}
+	}
+	return out[:n], nil
+}
+
+func parseCalendarValue(s string, lo, hi int, kind calendarValueKind) (int, error) {
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 && kind == calendarSeconds {
+		whole, frac = s[:i], s[i+1:]
+		if frac == "" {
+			return 0, fmt.Errorf("invalid value %q", s)
+		}
+	}
+
+	v, err := strconv.Atoi(whole)
+	if err != nil || v < 0 || whole[0] == '+' {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	switch kind {
+	case calendarYear:
+		if len(s) <= 2 {
+			if v < 70 {
+				v += 2000
+			} else {
+				v += 1900
+			}
+		}
+	case calendarSeconds:
+		if v > hi/calendarSecondMicros {
+			return 0, fmt.Errorf("invalid value %q", s)
+		}
+
+		// Round the fraction to microseconds.
+		var micros int
+		for i, c := range frac {
+			if c < '0' || c > '9' {
+				return 0, fmt.Errorf("invalid value %q", s)
+			}
+
+			switch {
+			case i < 6:
+				micros = micros*10 + int(c-'0')
+			case i == 6 && c >= '5':
+				micros++
+			}
+		}
+
+		for i := len(frac); i < 6; i++ {
+			micros *= 10
+		}
+		v = v*calendarSecondMicros + micros
+	}
+
+	if v < lo || v > hi {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	return v, nil
+}
+
+// String returns the normalized form of e, such as "Mon..Fri *-*-* 09:00:00".
+func (e CalendarEvent) String() string {
+	var out []string
+	if !e.anyWeekday {
+		out = append(out, e.formatWeekdays())
+	}
+
+	sep := "-"
+	if e.endOfMonth {
+		sep = "~"
+	}
+
+	out = append(out,
+		formatCalendarComponent(e.years, 4, calendarYear)+"-"+formatCalendarComponent(e.months, 2, calendarNumber)+sep+formatCalendarComponent(e.days, 2, calendarNumber),
+		formatCalendarComponent(e.hours, 2, calendarNumber)+":"+formatCalendarComponent(e.minutes, 2, calendarNumber)+":"+formatCalendarComponent(e.seconds, 2, calendarSeconds))
+
+	if e.zone != "" {
+		out = append(out, e.zone)
+	}
+	return strings.Join(out, " ")
+}
+
+// formatWeekdays formats the weekdays as a list, in which runs of three or more consecutive days are formatted as ranges.
+func (e CalendarEvent) formatWeekdays() string {
+	var out []string
+	for d := 1; d <= 7; d++ {
+		if !e.weekdays[d] {
+			continue
+		}
+
+		last := d
+		for last < 7 && e.weekdays[last+1] {
+			last++
+		}
+
+		switch last - d {
+		case 0:
+			out = append(out, calendarWeekdayNames[d-1])
+		case 1:
+			out = append(out, calendarWeekdayNames[d-1], calendarWeekdayNames[last-1])
+		default:
+			out = append(out, calendarWeekdayNames[d-1]+".."+calendarWeekdayNames[last-1])
+		}
+		d = last
+	}
+	return strings.Join(out, ",")
+}
+
+func formatCalendarComponent(items []calendarComponent, width int, kind calendarValueKind) string {
+	if items == nil {
+		return "*"
+	}
+
+	step := 1
+	if kind == calendarSeconds {
+		step = calendarSecondMicros
+	}
+
+	out := make([]string, len(items))
+	for i, c := range items {
+		out[i] = formatCalendarValue(c.start, width, kind)
+		if c.stop >= 0 {
+			out[i] += ".." + formatCalendarValue(c.stop, width, kind)
+		}
+
+		if c.repeat != 0 && (c.repeat != step || c.stop < 0) {
+			out[i] += "/" + formatCalendarValue(c.repeat, 0, kind)
+		}
+	}
+	return strings.Join(out, ",")
+}
+
+// formatCalendarValue formats v padded to width digits, and in the case of seconds, with a fraction of 6 digits if it is not 0.
+func formatCalendarValue(v, width int, kind calendarValueKind) string {
+	if kind != calendarSeconds {
+		return fmt.Sprintf("%0*d", width, v)
+	} else if v%calendarSecondMicros == 0 {
+		return fmt.Sprintf("%0*d", width, v/calendarSecondMicros)
+	}
+	return fmt.Sprintf("%0*d.%06d", width, v/calendarSecondMicros, v%calendarSecondMicros)
+}
+
+// Zone returns the name of the time zone of e, or an empty string if e does not specify a time zone.
+func (e CalendarEvent) Zone() string {
+	return e.zone
+}
+
+// Next returns the earliest time after t at which e elapses. If e specifies a time zone, e is matched against
+// the local time in that time zone, and the time returned is in the offset that is in effect at that time.
+// Otherwise, e is matched against the local time in the offset of t.
+// An error is returned if no such time exists within the supported range of dates.
+func (e CalendarEvent) Next(t OffsetDateTime) (OffsetDateTime, error) {
+	if e.offsets != nil {
+		return nextIn(e, t, e.offsets)
+	}
+	return nextIn(e, t, fixedOffset(t.o))
+}
+
+// Prev returns the latest time before t at which e elapses, in the same way as Next.
+func (e CalendarEvent) Prev(t OffsetDateTime) (OffsetDateTime, error) {
+	if e.offsets != nil {
+		return prevIn(e, t, e.offsets)
+	}
+	return prevIn(e, t, fixedOffset(t.o))
+}
+
+// NextIn returns the earliest time after t at which e elapses, where e is matched against the local time
+// according to offsets, unless e specifies a time zone, in which case offsets is ignored.
+// Skipped and repeated local times are handled in the same way as by [CronExpression.NextIn].
+func (e CalendarEvent) NextIn(t OffsetDateTime, offsets OffsetFunc) (OffsetDateTime, error) {
+	if e.offsets != nil {
+		offsets = e.offsets
+	}
+	return nextIn(e, t, offsets)
+}
+
+// PrevIn returns the latest time before t at which e elapses, in the same way as NextIn.
+func (e CalendarEvent) PrevIn(t OffsetDateTime, offsets OffsetFunc) (OffsetDateTime, error) {
+	if e.offsets != nil {
+		offsets = e.offsets
+	}
+	return prevIn(e, t, offsets)
+}
+
+func (e CalendarEvent) yearRange(year int) (first, last int) {
+	if e.years != nil {
+		return calendarMinYear, calendarMaxYear
+	}
+	return year - 400, year + 400
+}
+
+func (e CalendarEvent) matchesYear(year int) bool {
+	return matchesCalendarComponent(e.years, year, -1)
+}
+
+func (e CalendarEvent) matchesMonth(month int) bool {
+	return matchesCalendarComponent(e.months, month, -1)
+}
+
+func (e CalendarEvent) matchesDay(year, month, day int) bool {
+	if !isDateInBounds(year, month, day) {
+		return false
+	}
+
+	if !e.anyWeekday && !e.weekdays[getWeekday(int32(makeJDN(int64(year), int64(month), int64(day))))] {
+		return false
+	}
+
+	if e.endOfMonth {
+		return matchesCalendarComponent(e.days, day, getDaysInMonth(year, month))
+	}
+	return matchesCalendarComponent(e.days, day, -1)
+}
+
+func (e CalendarEvent) matchesHour(hour int) bool {
+	return matchesCalendarComponent(e.hours, hour, -1)
+}
+
+func (e CalendarEvent) matchesMinute(min int) bool {
+	return matchesCalendarComponent(e.minutes, min, -1)
+}
+
+func (e CalendarEvent) matchSecond(sec int, nsec int64, next bool) (int64, bool) {
+	if e.seconds == nil {
+		return 0, !next || nsec == 0
+	}
+
+	first := sec * calendarSecondMicros
+	last := first + calendarSecondMicros - 1
+
+	out, found := 0, false
+	for _, c := range e.seconds {
+		if next {
+			if v, ok := c.next(first + int((nsec+999)/1000)); ok && v <= last && (!found || v < out) {
+				out, found = v, true
+			}
+		} else if v, ok := c.prev(first + int(nsec/1000)); ok && v >= first && (!found || v > out) {
+			out, found = v, true
+		}
+	}
+	return int64(out-first) * 1000, found
+}
+
+// next returns the earliest value of c that is at least v.
+func (c calendarComponent) next(v int) (int, bool) {
+	switch {
+	case v <= c.start:
+		return c.start, true
+	case c.repeat == 0:
+		return 0, false
+	}
+
+	out := c.start + (v-c.start+c.repeat-1)/c.repeat*c.repeat
+	return out, c.stop < 0 || out <= c.stop
+}
+
+// prev returns the latest value of c that is at most v.
+func (c calendarComponent) prev(v int) (int, bool) {
+	switch {
+	case v < c.start:
+		return 0, false
+	case c.repeat == 0:
+		return c.start, true
+	case c.stop >= 0 && v > c.stop:
+		v = c.stop
+	}
+	return c.start + (v-c.start)/c.repeat*c.repeat, true
+}
+
+// matchesCalendarComponent reports whether v matches any of the items. If days is not -1,
+// the items are counted back from the last of the days, such that 1 is the last day.
+func matchesCalendarComponent(items []calendarComponent, v, days int) bool {
+	if items == nil {
+		return true
+	}
+
+	for _, c := range items {
+		start, stop := c.start, c.stop
+		if days != -1 {
+			start = days - start + 1
+			if stop != -1 {
+				start, stop = days-stop+1, start
+			}
+		}
+
+		switch {
+		case v < start, stop != -1 && v > stop:
+		case c.repeat == 0:
+			if v == start {
+				return true
+			}
+		case (v-start)%c.repeat == 0:
+			return true
+		}
+	}
+	return false
+}
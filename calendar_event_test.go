@@ -0,0 +1,173 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestParseCalendarEvent(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		expected string
+	}{
+		// The examples of normalized expressions from systemd.time(7).
+		{"Sat,Thu,Mon..Wed,Sat..Sun", "Mon..Thu,Sat,Sun *-*-* 00:00:00"},
+		{"Mon,Sun 12-*-* 2,1:23", "Mon,Sun 2012-*-* 01,02:23:00"},
+		{"Wed *-1", "Wed *-*-01 00:00:00"},
+		{"Wed..Wed,Wed *-1", "Wed *-*-01 00:00:00"},
+		{"Wed, 17:48", "Wed *-*-* 17:48:00"},
+		{"Wed..Sat,Tue 12-10-15 1:2:3", "Tue..Sat 2012-10-15 01:02:03"},
+		{"*-*-7 0:0:0", "*-*-07 00:00:00"},
+		{"10-15", "*-10-15 00:00:00"},
+		{"monday *-12-* 17:00", "Mon *-12-* 17:00:00"},
+		{"Mon,Fri *-*-3,1,2 *:30:45", "Mon,Fri *-*-01,02,03 *:30:45"},
+		{"12,14,13,12:20,10,30", "*-*-* 12,13,14:10,20,30:00"},
+		{"12..14:10,20,30", "*-*-* 12..14:10,20,30:00"},
+		{"mon,fri *-1/2-1,3 *:30:45", "Mon,Fri *-01/2-01,03 *:30:45"},
+		{"03-05 08:05:40", "*-03-05 08:05:40"},
+		{"08:05:40", "*-*-* 08:05:40"},
+		{"05:40", "*-*-* 05:40:00"},
+		{"Sat,Sun 12-05 08:05:40", "Sat,Sun *-12-05 08:05:40"},
+		{"Sat,Sun 08:05:40", "Sat,Sun *-*-* 08:05:40"},
+		{"2003-03-05 05:40", "2003-03-05 05:40:00"},
+		{"05:40:23.4200004/3.1700005", "*-*-* 05:40:23.420000/3.170001"},
+		{"2003-02..04-05", "2003-02..04-05 00:00:00"},
+		{"2003-03-05 05:40 UTC", "2003-03-05 05:40:00 UTC"},
+		{"2003-03-05", "2003-03-05 00:00:00"},
+		{"03-05", "*-03-05 00:00:00"},
+		{"hourly", "*-*-* *:00:00"},
+		{"daily", "*-*-* 00:00:00"},
+		{"daily UTC", "*-*-* 00:00:00 UTC"},
+		{"monthly", "*-*-01 00:00:00"},
+		{"weekly", "Mon *-*-* 00:00:00"},
+		{"weekly Pacific/Auckland", "Mon *-*-* 00:00:00 Pacific/Auckland"},
+		{"yearly", "*-01-01 00:00:00"},
+		{"annually", "*-01-01 00:00:00"},
+		{"*:2/3", "*-*-* *:02/3:00"},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			e, err := chrono.ParseCalendarEvent(tt.input)
+			if err != nil {
+				t.Fatalf("failed to parse calendar event: %v", err)
+			} else if str := e.String(); str != tt.expected {
+				t.Errorf("e.String() = %v, want %v", str, tt.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{
+		"",
+		"Foo",
+		"*-13-01",
+		"25:00",
+		"*-*-* 12:00 Nowhere/Zone",
+		"*-*-* 12:00:00:00",
+		"1969-01-01",
+		"*-*-5..1",
+		"*-*-*/0",
+		"Mon Tue",
+		"Mon,,Tue",
+		"*:*:5.",
+		"*:*:5.5x",
+		"*:*:59.9999995",
+		"*:*:0/0.0000001",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := chrono.ParseCalendarEvent(input); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestCalendarEvent_Next_fractionalSeconds(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		from  chrono.OffsetDateTime
+		next  chrono.OffsetDateTime
+		prev  chrono.OffsetDateTime
+	}{
+		{
+			input: "05:40:23.42",
+			from:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 5, 40, 23, 420000000, 0, 0),
+			next:  chrono.OffsetDateTimeOf(2026, chrono.January, 16, 5, 40, 23, 420000000, 0, 0),
+			prev:  chrono.OffsetDateTimeOf(2026, chrono.January, 14, 5, 40, 23, 420000000, 0, 0),
+		},
+		{
+			input: "05:40:23.4200004/3.1700005",
+			from:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 5, 40, 26, 0, 0, 0),
+			next:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 5, 40, 26, 590001000, 0, 0),
+			prev:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 5, 40, 23, 420000000, 0, 0),
+		},
+		{
+			input: "*:*:*/0.25",
+			from:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 10, 0, 59, 900000000, 0, 0),
+			next:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 10, 1, 0, 0, 0, 0),
+			prev:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 10, 0, 59, 750000000, 0, 0),
+		},
+		{
+			input: "*:*:*",
+			from:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 10, 0, 30, 500000000, 0, 0),
+			next:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 10, 0, 31, 0, 0, 0),
+			prev:  chrono.OffsetDateTimeOf(2026, chrono.January, 15, 10, 0, 30, 0, 0, 0),
+		},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			e, err := chrono.ParseCalendarEvent(tt.input)
+			if err != nil {
+				t.Fatalf("failed to parse calendar event: %v", err)
+			}
+
+			if next, err := e.Next(tt.from); err != nil || next.Compare(tt.next) != 0 {
+				t.Errorf("e.Next() = %v, %v, want %v", next, err, tt.next)
+			}
+
+			if prev, err := e.Prev(tt.from); err != nil || prev.Compare(tt.prev) != 0 {
+				t.Errorf("e.Prev() = %v, %v, want %v", prev, err, tt.prev)
+			}
+		})
+	}
+}
+
+func TestCalendarEvent_Next(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		from  string
+		next  string
+		prev  string
+	}{
+		{"Mon..Fri *-*-* 09:00:00", "2026-01-16T17:00:00Z", "2026-01-19T09:00:00Z", "2026-01-16T09:00:00Z"},
+		{"*-*-01 00:00:00 Europe/Berlin", "2026-01-15T12:00:00Z", "2026-02-01T00:00:00+01:00", "2026-01-01T00:00:00+01:00"},
+		{"*-*-01 00:00:00 Europe/Berlin", "2026-03-15T12:00:00Z", "2026-04-01T00:00:00+02:00", "2026-03-01T00:00:00+01:00"},
+		{"*-02~01", "2028-01-15T00:00:00Z", "2028-02-29T00:00:00Z", "2027-02-28T00:00:00Z"},
+		{"Mon *-05~07/1", "2026-01-15T00:00:00Z", "2026-05-25T00:00:00Z", "2025-05-26T00:00:00Z"},
+		{"*:0/15", "2026-01-15T10:07:00Z", "2026-01-15T10:15:00Z", "2026-01-15T10:00:00Z"},
+		{"quarterly", "2026-01-15T00:00:00Z", "2026-04-01T00:00:00Z", "2026-01-01T00:00:00Z"},
+		{"Sat,Sun 10:00 UTC", "2026-01-15T12:00:00+05:00", "2026-01-17T10:00:00Z", "2026-01-11T10:00:00Z"},
+		{"*-*-* 12:00:00", "2026-01-15T12:00:00+05:30", "2026-01-16T12:00:00+05:30", "2026-01-14T12:00:00+05:30"},
+		{"*-*-* 02:30:00 Europe/Berlin", "2026-03-28T12:00:00+01:00", "2026-03-29T03:30:00+02:00", "2026-03-28T02:30:00+01:00"},
+		{"*-*-* 02:30:00 Europe/Berlin", "2026-10-25T00:00:00+02:00", "2026-10-25T02:30:00+02:00", "2026-10-24T02:30:00+02:00"},
+		{"2026-02-30", "2026-01-15T00:00:00Z", "", ""},
+	} {
+		t.Run(tt.input+" "+tt.from, func(t *testing.T) {
+			e, err := chrono.ParseCalendarEvent(tt.input)
+			if err != nil {
+				t.Fatalf("failed to parse calendar event: %v", err)
+			}
+
+			from := parseOffsetDateTime(t, tt.from)
+			if next, err := e.Next(from); tt.next == "" && err == nil {
+				t.Errorf("e.Next() = %v, want error", next)
+			} else if tt.next != "" && (err != nil || next.Format(chrono.ISO8601) != tt.next) {
+				t.Errorf("e.Next() = %v, %v, want %v", next.Format(chrono.ISO8601), err, tt.next)
+			}
+
+			if prev, err := e.Prev(from); tt.prev == "" && err == nil {
+				t.Errorf("e.Prev() = %v, want error", prev)
+			} else if tt.prev != "" && (err != nil || prev.Format(chrono.ISO8601) != tt.prev) {
+				t.Errorf("e.Prev() = %v, %v, want %v", prev.Format(chrono.ISO8601), err, tt.prev)
+			}
+		})
+	}
+}
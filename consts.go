@@ -82,3 +82,87 @@ var longMonthNames = [12]string{
 	November - 1:  "November",
 	December - 1:  "December",
 }
+
+// Unit specifies a unit of time, or a unit of the calendar.
+type Unit int
+
+// The units of time and of the calendar, from smallest to largest.
+const (
+	UnitNanosecond Unit = iota + 1
+	UnitMicrosecond
+	UnitMillisecond
+	UnitSecond
+	UnitMinute
+	UnitHour
+	UnitDay
+	UnitWeek
+	UnitMonth
+	UnitQuarter
+	UnitYear
+)
+
+func (u Unit) String() string {
+	if u < UnitNanosecond || u > UnitYear {
+		return fmt.Sprintf("%%!Unit(%d)", u)
+	}
+	return unitNames[u-1]
+}
+
+var unitNames = [11]string{
+	UnitNanosecond - 1:  "nanosecond",
+	UnitMicrosecond - 1: "microsecond",
+	UnitMillisecond - 1: "millisecond",
+	UnitSecond - 1:      "second",
+	UnitMinute - 1:      "minute",
+	UnitHour - 1:        "hour",
+	UnitDay - 1:         "day",
+	UnitWeek - 1:        "week",
+	UnitMonth - 1:       "month",
+	UnitQuarter - 1:     "quarter",
+	UnitYear - 1:        "year",
+}
+
+// unitExtents are the exact lengths in nanoseconds of the units up to and including UnitWeek.
+var unitExtents = [8]uint64{
+	UnitNanosecond - 1:  1,
+	UnitMicrosecond - 1: uint64(Microsecond),
+	UnitMillisecond - 1: uint64(Millisecond),
+	UnitSecond - 1:      uint64(Second),
+	UnitMinute - 1:      uint64(Minute),
+	UnitHour - 1:        uint64(Hour),
+	UnitDay - 1:         uint64(24 * Hour),
+	UnitWeek - 1:        uint64(7 * 24 * Hour),
+}
+
+// EndOfMonth specifies how the AddDateWith functions resolve a day that does not exist in the resulting month,
+// such as when adding one month to 31st January.
+type EndOfMonth int
+
+// The end-of-month policies.
+const (
+	// EndOfMonthOverflow carries the excess days into the following month, such that 31st January plus one month
+	// is 3rd March (or 2nd March in a leap year). This is the behavior of the AddDate functions.
+	EndOfMonthOverflow EndOfMonth = iota
+	// EndOfMonthClamp clamps the day to the last day of the resulting month, such that 31st January plus one month is 28th February.
+	EndOfMonthClamp
+	// EndOfMonthError reports an error if the day does not exist in the resulting month.
+	EndOfMonthError
+	// EndOfMonthStick clamps the day in the same way as EndOfMonthClamp, but also keeps a date that falls on the last day
+	// of its month on the last day of the resulting month, such that 28th February plus one month is 31st March.
+	// This is commonly used by financial schedules.
+	EndOfMonthStick
+)
+
+func (e EndOfMonth) String() string {
+	if e < EndOfMonthOverflow || e > EndOfMonthStick {
+		return fmt.Sprintf("%%!EndOfMonth(%d)", e)
+	}
+	return endOfMonthNames[e]
+}
+
+var endOfMonthNames = [4]string{
+	EndOfMonthOverflow: "overflow",
+	EndOfMonthClamp:    "clamp",
+	EndOfMonthError:    "error",
+	EndOfMonthStick:    "stick",
+}
@@ -0,0 +1,583 @@
+package chrono
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CronExpression is a cron expression, which specifies the times at which a job is scheduled to run.
+//
+// An expression consists of 5 fields, separated by whitespace: minute (0-59), hour (0-23), day of the month (1-31),
+// month (1-12 or JAN-DEC), and day of the week (0-7 or SUN-SAT, where both 0 and 7 are Sunday).
+// A seconds field (0-59) can be added before the minute field, in which case a year field (1970-2099) can be added
+// after the day of the week field. Each field is one of *, a value, a range a-b, or a step */n, a/n or a-b/n,
+// or a comma-separated list of any of these. The day of the month and day of the week fields additionally accept
+// ? (the same as *), and the following extensions:
+//   - L in the day of the month field is the last day of the month, and L-n is n days before the last day of the month;
+//   - nW in the day of the month field is the weekday (Monday to Friday) nearest to day n within the same month,
+//     and LW is the last weekday of the month;
+//   - nL in the day of the week field is the last day n of the month, such as 5L for the last Friday,
+//     and L on its own is Saturday;
+//   - n#k in the day of the week field is the kth day n of the month, such as 1#2 for the second Monday.
+//
+// As in Vixie cron, if either the day of the month or day of the week field contains an item that starts with * or is ?,
+// such as * or */2, a day matches if it matches both fields, and otherwise if it matches either field. Additionally, the following macros are supported:
+// @yearly (or @annually), @monthly, @weekly, @daily (or @midnight), and @hourly.
+type CronExpression struct {
+	str                             string
+	seconds, minutes, hours         cronBits
+	daysOfMonth, months, daysOfWeek cronBits
+	years                           *cronBits
+	starDayOfMonth, starDayOfWeek   bool
+
+	lastDayOffsets  []int // L and L-n
+	nearestWeekdays []int // nW
+	lastWeekday     bool  // LW
+	lastDaysOfWeek  []int // nL
+	nthDaysOfWeek   [][2]int
+}
+
+// cronBits is a set of the values of a field of a cron expression.
+type cronBits [3]uint64
+
+func (b *cronBits) set(v int) {
+	b[v/64] |= 1 << uint(v%64)
+}
+
+func (b cronBits) has(v int) bool {
+	return v >= 0 && v < 192 && b[v/64]&(1<<uint(v%64)) != 0
+}
+
+const (
+	cronMinYear = 1970
+	cronMaxYear = 2099
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	cronMonthNames   = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	cronWeekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// ParseCronExpression parses a cron expression, as described by [CronExpression].
+func ParseCronExpression(s string) (CronExpression, error) {
+	out := CronExpression{str: s}
+
+	expr := strings.TrimSpace(s)
+	if strings.HasPrefix(expr, "@") {
+		var ok bool
+		if expr, ok = cronMacros[strings.ToLower(expr)]; !ok {
+			return CronExpression{}, fmt.Errorf("parsing cron expression: unsupported macro %q", s)
+		}
+	}
+
+	fields := strings.Fields(strings.ToUpper(expr))
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6, 7:
+	default:
+		return CronExpression{}, fmt.Errorf("parsing cron expression: expecting 5, 6 or 7 fields, found %d", len(fields))
+	}
+
+	var err error
+	if out.seconds, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return CronExpression{}, fmt.Errorf("parsing cron expression: seconds: %v", err)
+	}
+
+	if out.minutes, err = parseCronField(fields[1], 0, 59, nil); err != nil {
+		return CronExpression{}, fmt.Errorf("parsing cron expression: minutes: %v", err)
+	}
+
+	if out.hours, err = parseCronField(fields[2], 0, 23, nil); err != nil {
+		return CronExpression{}, fmt.Errorf("parsing cron expression: hours: %v", err)
+	}
+
+	if err = out.parseDaysOfMonth(fields[3]); err != nil {
+		return CronExpression{}, fmt.Errorf("parsing cron expression: day of month: %v", err)
+	}
+
+	if out.months, err = parseCronField(fields[4], 1, 12, cronMonthNames); err != nil {
+		return CronExpression{}, fmt.Errorf("parsing cron expression: month: %v", err)
+	}
+
+	if err = out.parseDaysOfWeek(fields[5]); err != nil {
+		return CronExpression{}, fmt.Errorf("parsing cron expression: day of week: %v", err)
+	}
+
+	if len(fields) == 7 {
+		years, err := parseCronField(fields[6], cronMinYear, cronMaxYear, nil)
+		if err != nil {
+			return CronExpression{}, fmt.Errorf("parsing cron expression: year: %v", err)
+		}
+
+		if !hasCronStar(fields[6]) {
+			out.years = &years
+		}
+	}
+	return out, nil
+}
+
+// parseCronField parses a field whose values are in the range lo to hi, and optionally have names, which are numbered from lo.
+// If hi is too large to be stored in cronBits, as for years, the values are stored relative to lo.
+func parseCronField(s string, lo, hi int, names []string) (bits cronBits, err error) {
+	base := 0
+	if hi >= 64*3 {
+		base = lo
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		step := 1
+		hasStep := false
+		if i := strings.IndexByte(item, '/'); i >= 0 {
+			hasStep = true
+			if step, err = strconv.Atoi(item[i+1:]); err != nil || step < 1 {
+				return cronBits{}, fmt.Errorf("invalid step %q", item[i+1:])
+			}
+			item = item[:i]
+		}
+
+		var from, to int
+		switch i := strings.IndexByte(item, '-'); {
+		case item == "*":
+			from, to = lo, hi
+		case i > 0:
+			if from, err = parseCronValue(item[:i], lo, hi, names); err != nil {
+				return cronBits{}, err
+			}
+
+			if to, err = parseCronValue(item[i+1:], lo, hi, names); err != nil {
+				return cronBits{}, err
+			} else if to < from {
+				return cronBits{}, fmt.Errorf("invalid range %q", item)
+			}
+		default:
+			if from, err = parseCronValue(item, lo, hi, names); err != nil {
+				return cronBits{}, err
+			}
+
+			to = from
+			if hasStep {
+				to = hi
+			}
+		}
+
+		for v := from; v <= to; v += step {
+			bits.set(v - base)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, lo, hi int, names []string) (int, error) {
+	for i, name := range names {
+		if s == name {
+			return lo + i, nil
+		}
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < lo || v > hi {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	return v, nil
+}
+
+// hasCronStar reports whether the field s includes * without a step, and so matches every value.
+func hasCronStar(s string) bool {
+	for _, item := range strings.Split(s, ",") {
+		if item == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CronExpression) parseDaysOfMonth(s string) error {
+	if s == "?" {
+		s = "*"
+	}
+
+	var plain []string
+	for _, item := range strings.Split(s, ",") {
+		c.starDayOfMonth = c.starDayOfMonth || strings.HasPrefix(item, "*")
+		switch {
+		case item == "L":
+			c.lastDayOffsets = append(c.lastDayOffsets, 0)
+		case item == "LW":
+			c.lastWeekday = true
+		case strings.HasPrefix(item, "L-"):
+			n, err := strconv.Atoi(item[2:])
+			if err != nil || n < 0 || n > 30 {
+				return fmt.Errorf("invalid value %q", item)
+			}
+			c.lastDayOffsets = append(c.lastDayOffsets, n)
+		case strings.HasSuffix(item, "W"):
+			n, err := parseCronValue(item[:len(item)-1], 1, 31, nil)
+			if err != nil {
+				return err
+			}
+			c.nearestWeekdays = append(c.nearestWeekdays, n)
+		default:
+			plain = append(plain, item)
+		}
+	}
+
+	if len(plain) > 0 {
+		var err error
+		if c.daysOfMonth, err = parseCronField(strings.Join(plain, ","), 1, 31, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *CronExpression) parseDaysOfWeek(s string) error {
+	if s == "?" {
+		s = "*"
+	}
+
+	var plain []string
+	for _, item := range strings.Split(s, ",") {
+		c.starDayOfWeek = c.starDayOfWeek || strings.HasPrefix(item, "*")
+		switch i := strings.IndexByte(item, '#'); {
+		case item == "L":
+			plain = append(plain, "6")
+		case i > 0:
+			d, err := parseCronValue(item[:i], 0, 7, cronWeekdayNames)
+			if err != nil {
+				return err
+			}
+
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n < 1 || n > 5 {
+				return fmt.Errorf("invalid value %q", item)
+			}
+			c.nthDaysOfWeek = append(c.nthDaysOfWeek, [2]int{d % 7, n})
+		case strings.HasSuffix(item, "L"):
+			d, err := parseCronValue(item[:len(item)-1], 0, 7, cronWeekdayNames)
+			if err != nil {
+				return err
+			}
+			c.lastDaysOfWeek = append(c.lastDaysOfWeek, d%7)
+		default:
+			plain = append(plain, item)
+		}
+	}
+
+	if len(plain) > 0 {
+		bits, err := parseCronField(strings.Join(plain, ","), 0, 7, cronWeekdayNames)
+		if err != nil {
+			return err
+		}
+
+		if bits.has(7) {
+			bits.set(0)
+		}
+		c.daysOfWeek = bits
+	}
+	return nil
+}
+
+// String returns the cron expression as it was parsed.
+func (c CronExpression) String() string {
+	return c.str
+}
+
+// Next returns the earliest time after t that matches c, in the offset of t.
+// An error is returned if no such time exists within the supported range of dates.
+func (c CronExpression) Next(t OffsetDateTime) (OffsetDateTime, error) {
+	return c.NextIn(t, fixedOffset(t.o))
+}
+
+// Prev returns the latest time before t that matches c, in the offset of t.
+// An error is returned if no such time exists within the supported range of dates.
+func (c CronExpression) Prev(t OffsetDateTime) (OffsetDateTime, error) {
+	return c.PrevIn(t, fixedOffset(t.o))
+}
+
+// NextIn returns the earliest time after t that matches c, where c is matched against the local time
+// according to offsets, and the time returned is in the offset that is in effect at that time.
+//
+// If the offset changes such that a local time is skipped, that local time is shifted forward by the length of the gap,
+// such that 02:30 becomes 03:30 if the local time skips from 02:00 to 03:00. If the offset changes such that a local time
+// is repeated, it matches only at its first occurrence.
+func (c CronExpression) NextIn(t OffsetDateTime, offsets OffsetFunc) (OffsetDateTime, error) {
+	return nextIn(c, t, offsets)
+}
+
+// PrevIn returns the latest time before t that matches c, in the same way as NextIn.
+func (c CronExpression) PrevIn(t OffsetDateTime, offsets OffsetFunc) (OffsetDateTime, error) {
+	return prevIn(c, t, offsets)
+}
+
+// calendarMatcher matches the components of local times, such as against the fields of a cron expression.
+type calendarMatcher interface {
+	// yearRange returns the range of years that are searched for a match from year.
+	yearRange(year int) (first, last int)
+	matchesYear(year int) bool
+	matchesMonth(month int) bool
+	matchesDay(year, month, day int) bool
+	matchesHour(hour int) bool
+	matchesMinute(min int) bool
+	// matchSecond returns the earliest fraction of the second sec, in nanoseconds, that matches and is at least nsec,
+	// or if next is false, the latest that matches and is at most nsec.
+	matchSecond(sec int, nsec int64, next bool) (int64, bool)
+}
+
+// nextIn returns the earliest time after t whose local time according to offsets matches m.
+func nextIn(m calendarMatcher, t OffsetDateTime, offsets OffsetFunc) (OffsetDateTime, error) {
+	local := dateTimeToOffset(t.v, t.o, int64(offsets(t)))
+	for {
+		var err error
+		if local, err = nextMatch(m, local); err != nil {
+			return OffsetDateTime{}, err
+		}
+
+		if out := resolveLocal(local, offsets); compareInstants(out, t) == 1 {
+			return out, nil
+		}
+	}
+}
+
+// prevIn returns the latest time before t whose local time according to offsets matches m.
+func prevIn(m calendarMatcher, t OffsetDateTime, offsets OffsetFunc) (OffsetDateTime, error) {
+	local := dateTimeToOffset(t.v, t.o, int64(offsets(t)))
+	for {
+		var err error
+		if local, err = prevMatch(m, local); err != nil {
+			return OffsetDateTime{}, err
+		}
+
+		if out := resolveLocal(local, offsets); compareInstants(out, t) == -1 {
+			return out, nil
+		}
+	}
+}
+
+// nextMatch returns the earliest local time after v that matches m.
+func nextMatch(m calendarMatcher, v dateTime) (dateTime, error) {
+	year, month, day, err := fromDate(v.date)
+	if err != nil {
+		return dateTime{}, err
+	}
+
+	hour, min, sec, nsec := fromTime(v.time)
+	from := int64(nsec) + 1
+
+	_, last := m.yearRange(year)
+	if last > maxYear {
+		last = maxYear
+	}
+
+	for y := year; y <= last; y++ {
+		if m.matchesYear(y) {
+			for mo := month; mo <= 12; mo++ {
+				if m.matchesMonth(mo) {
+					for d := day; d <= getDaysInMonth(y, mo); d++ {
+						if m.matchesDay(y, mo, d) {
+							for h := hour; h <= 23; h++ {
+								if m.matchesHour(h) {
+									for mi := min; mi <= 59; mi++ {
+										if m.matchesMinute(mi) {
+											for s := sec; s <= 59; s++ {
+												if f, ok := m.matchSecond(s, from, true); ok {
+													return makeLocal(y, mo, d, h, mi, s, f)
+												}
+												from = 0
+											}
+										}
+										sec, from = 0, 0
+									}
+								}
+								min, sec, from = 0, 0, 0
+							}
+						}
+						hour, min, sec, from = 0, 0, 0, 0
+					}
+				}
+				day, hour, min, sec, from = 1, 0, 0, 0, 0
+			}
+		}
+		month, day, hour, min, sec, from = 1, 1, 0, 0, 0, 0
+	}
+	return dateTime{}, fmt.Errorf("no matching time")
+}
+
+// prevMatch returns the latest local time before v that matches m.
+func prevMatch(m calendarMatcher, v dateTime) (dateTime, error) {
+	year, month, day, err := fromDate(v.date)
+	if err != nil {
+		return dateTime{}, err
+	}
+
+	hour, min, sec, nsec := fromTime(v.time)
+	to := int64(nsec) - 1
+	if to < 0 {
+		sec, to = sec-1, nsecPerSecond-1
+	}
+
+	first, _ := m.yearRange(year)
+	if first < minYear {
+		first = minYear
+	}
+
+	for y := year; y >= first; y-- {
+		if m.matchesYear(y) {
+			for mo := month; mo >= 1; mo-- {
+				if m.matchesMonth(mo) {
+					for d := day; d >= 1; d-- {
+						if m.matchesDay(y, mo, d) {
+							for h := hour; h >= 0; h-- {
+								if m.matchesHour(h) {
+									for mi := min; mi >= 0; mi-- {
+										if m.matchesMinute(mi) {
+											for s := sec; s >= 0; s-- {
+												if f, ok := m.matchSecond(s, to, false); ok {
+													return makeLocal(y, mo, d, h, mi, s, f)
+												}
+												to = nsecPerSecond - 1
+											}
+										}
+										sec, to = 59, nsecPerSecond-1
+									}
+								}
+								min, sec, to = 59, 59, nsecPerSecond-1
+							}
+						}
+						hour, min, sec, to = 23, 59, 59, nsecPerSecond-1
+					}
+				}
+
+				if mo > 1 {
+					day = getDaysInMonth(y, mo-1)
+				}
+				hour, min, sec, to = 23, 59, 59, nsecPerSecond-1
+			}
+		}
+		month, day, hour, min, sec, to = 12, 31, 23, 59, 59, nsecPerSecond-1
+	}
+	return dateTime{}, fmt.Errorf("no matching time")
+}
+
+func makeLocal(year, month, day, hour, min, sec int, nsec int64) (dateTime, error) {
+	date, err := makeDate(year, month, day)
+	if err != nil {
+		return dateTime{}, err
+	}
+	return dateTime{date: date, time: int64(hour)*oneHour + int64(min)*oneMinute + int64(sec)*oneSecond + nsec}, nil
+}
+
+func (c CronExpression) yearRange(year int) (first, last int) {
+	if c.years != nil {
+		return cronMinYear, cronMaxYear
+	}
+	return year - 400, year + 400
+}
+
+func (c CronExpression) matchesYear(year int) bool {
+	return c.years == nil || (year >= cronMinYear && year <= cronMaxYear && c.years.has(year-cronMinYear))
+}
+
+func (c CronExpression) matchesMonth(month int) bool {
+	return c.months.has(month)
+}
+
+func (c CronExpression) matchesHour(hour int) bool {
+	return c.hours.has(hour)
+}
+
+func (c CronExpression) matchesMinute(min int) bool {
+	return c.minutes.has(min)
+}
+
+func (c CronExpression) matchSecond(sec int, nsec int64, next bool) (int64, bool) {
+	return 0, c.seconds.has(sec) && (!next || nsec == 0)
+}
+
+// matchesDay reports whether the date matches the day of the month and day of the week fields.
+func (c CronExpression) matchesDay(year, month, day int) bool {
+	if !isDateInBounds(year, month, day) {
+		return false
+	}
+
+	if c.starDayOfMonth || c.starDayOfWeek {
+		return c.matchesDayOfMonth(year, month, day) && c.matchesDayOfWeek(year, month, day)
+	}
+	return c.matchesDayOfMonth(year, month, day) || c.matchesDayOfWeek(year, month, day)
+}
+
+func (c CronExpression) matchesDayOfMonth(year, month, day int) bool {
+	if c.daysOfMonth.has(day) {
+		return true
+	}
+
+	days := getDaysInMonth(year, month)
+	for _, n := range c.lastDayOffsets {
+		if day == days-n {
+			return true
+		}
+	}
+
+	weekday := getWeekday(int32(makeJDN(int64(year), int64(month), int64(day))))
+	if weekday >= int(Saturday) {
+		return false
+	}
+
+	if c.lastWeekday && (day == days || (day >= days-2 && weekday == int(Friday))) {
+		return true
+	}
+
+	for _, n := range c.nearestWeekdays {
+		if n > days {
+			continue
+		}
+
+		switch nearest := getWeekday(int32(makeJDN(int64(year), int64(month), int64(n)))); {
+		case nearest < int(Saturday):
+			if day == n {
+				return true
+			}
+		case nearest == int(Saturday):
+			if (n > 1 && day == n-1) || (n == 1 && day == n+2) {
+				return true
+			}
+		default:
+			if (n < days && day == n+1) || (n == days && day == n-2) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c CronExpression) matchesDayOfWeek(year, month, day int) bool {
+	// Cron numbers the days of the week from Sunday = 0.
+	weekday := getWeekday(int32(makeJDN(int64(year), int64(month), int64(day)))) % 7
+	if c.daysOfWeek.has(weekday) {
+		return true
+	}
+
+	for _, d := range c.lastDaysOfWeek {
+		if d == weekday && day+7 > getDaysInMonth(year, month) {
+			return true
+		}
+	}
+
+	for _, d := range c.nthDaysOfWeek {
+		if d[0] == weekday && (day-1)/7+1 == d[1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestCronExpression_Next(t *testing.T) {
+	for _, tt := range []struct {
+		expr string
+		from string
+		next string
+		prev string
+	}{
+		{"* * * * *", "2026-01-15T10:00:30Z", "2026-01-15T10:01:00Z", "2026-01-15T10:00:00Z"},
+		{"*/15 * * * *", "2026-01-15T10:00:00Z", "2026-01-15T10:15:00Z", "2026-01-15T09:45:00Z"},
+		{"0 9-17/4 * * MON-FRI", "2026-01-16T17:30:00Z", "2026-01-19T09:00:00Z", "2026-01-16T17:00:00Z"},
+		{"30 2 * * *", "2026-01-15T02:30:00Z", "2026-01-16T02:30:00Z", "2026-01-14T02:30:00Z"},
+		{"0 0 29 2 *", "2026-01-15T00:00:00Z", "2028-02-29T00:00:00Z", "2024-02-29T00:00:00Z"},
+		{"0 0 31 * *", "2026-04-15T00:00:00Z", "2026-05-31T00:00:00Z", "2026-03-31T00:00:00Z"},
+		{"0 12 1,15 * 5", "2026-01-02T13:00:00Z", "2026-01-09T12:00:00Z", "2026-01-02T12:00:00Z"},
+		{"0 0 */2 * MON", "2026-01-15T00:00:00Z", "2026-01-19T00:00:00Z", "2026-01-05T00:00:00Z"},
+		{"0 0 1,15 * */2", "2026-01-15T00:00:00Z", "2026-02-01T00:00:00Z", "2026-01-01T00:00:00Z"},
+		{"0 0 * JAN,jul SUN", "2026-01-31T00:00:00Z", "2026-07-05T00:00:00Z", "2026-01-25T00:00:00Z"},
+		{"0 0 * * 7", "2026-01-15T00:00:00Z", "2026-01-18T00:00:00Z", "2026-01-11T00:00:00Z"},
+		{"15,45 */20 * * * *", "2026-01-15T10:00:15Z", "2026-01-15T10:00:45Z", "2026-01-15T09:40:45Z"},
+		{"0 0 0 1 1 ? 2030/5", "2026-01-15T00:00:00Z", "2030-01-01T00:00:00Z", ""},
+		{"0 0 0 1 1 ? 2030", "2031-01-15T00:00:00Z", "", "2030-01-01T00:00:00Z"},
+		{"0 0 L * ?", "2026-02-15T00:00:00Z", "2026-02-28T00:00:00Z", "2026-01-31T00:00:00Z"},
+		{"0 0 L-2 * ?", "2026-02-15T00:00:00Z", "2026-02-26T00:00:00Z", "2026-01-29T00:00:00Z"},
+		{"0 0 LW * ?", "2026-01-15T00:00:00Z", "2026-01-30T00:00:00Z", "2025-12-31T00:00:00Z"},
+		{"0 0 15W * ?", "2026-02-01T00:00:00Z", "2026-02-16T00:00:00Z", "2026-01-15T00:00:00Z"},
+		{"0 0 1W * ?", "2026-02-15T00:00:00Z", "2026-03-02T00:00:00Z", "2026-02-02T00:00:00Z"},
+		{"0 0 31W * ?", "2026-05-01T00:00:00Z", "2026-05-29T00:00:00Z", "2026-03-31T00:00:00Z"},
+		{"0 0 ? * 5L", "2026-01-15T00:00:00Z", "2026-01-30T00:00:00Z", "2025-12-26T00:00:00Z"},
+		{"0 0 ? * 1#2", "2026-01-15T00:00:00Z", "2026-02-09T00:00:00Z", "2026-01-12T00:00:00Z"},
+		{"0 0 ? * FRI#5", "2026-01-01T00:00:00Z", "2026-01-30T00:00:00Z", "2025-10-31T00:00:00Z"},
+		{"@hourly", "2026-01-15T10:20:00Z", "2026-01-15T11:00:00Z", "2026-01-15T10:00:00Z"},
+		{"@daily", "2026-01-15T10:20:00+05:30", "2026-01-16T00:00:00+05:30", "2026-01-15T00:00:00+05:30"},
+		{"@weekly", "2026-01-15T10:20:00Z", "2026-01-18T00:00:00Z", "2026-01-11T00:00:00Z"},
+		{"@monthly", "2026-01-15T10:20:00Z", "2026-02-01T00:00:00Z", "2026-01-01T00:00:00Z"},
+		{"@annually", "2026-01-15T10:20:00Z", "2027-01-01T00:00:00Z", "2026-01-01T00:00:00Z"},
+		{"0 0 30 2 *", "2026-01-15T00:00:00Z", "", ""},
+	} {
+		t.Run(tt.expr+" "+tt.from, func(t *testing.T) {
+			c, err := chrono.ParseCronExpression(tt.expr)
+			if err != nil {
+				t.Fatalf("failed to parse expression: %v", err)
+			} else if str := c.String(); str != tt.expr {
+				t.Errorf("c.String() = %v, want %v", str, tt.expr)
+			}
+
+			from := parseOffsetDateTime(t, tt.from)
+			if next, err := c.Next(from); tt.next == "" && err == nil {
+				t.Errorf("c.Next() = %v, want error", next)
+			} else if tt.next != "" && (err != nil || next.Format(chrono.ISO8601) != tt.next) {
+				t.Errorf("c.Next() = %v, %v, want %v", next.Format(chrono.ISO8601), err, tt.next)
+			}
+
+			if prev, err := c.Prev(from); tt.prev == "" && err == nil {
+				t.Errorf("c.Prev() = %v, want error", prev)
+			} else if tt.prev != "" && (err != nil || prev.Format(chrono.ISO8601) != tt.prev) {
+				t.Errorf("c.Prev() = %v, %v, want %v", prev.Format(chrono.ISO8601), err, tt.prev)
+			}
+		})
+	}
+}
+
+func TestParseCronExpression(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"* * * FOO *",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * 32W * *",
+		"* * * * 1#6",
+		"* * * * 9L",
+		"0 0 0 * * * 1969",
+		"@reboot",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := chrono.ParseCronExpression(expr); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestCronExpression_NextIn(t *testing.T) {
+	// The offset changes from +01:00 to +02:00 at 01:00 UTC on 29th March 2026,
+	// and back to +01:00 at 01:00 UTC on 25th October 2026.
+	spring := chrono.OffsetDateTimeOf(2026, chrono.March, 29, 1, 0, 0, 0, 0, 0)
+	autumn := chrono.OffsetDateTimeOf(2026, chrono.October, 25, 1, 0, 0, 0, 0, 0)
+	offsets := func(t chrono.OffsetDateTime) chrono.Offset {
+		if t.Compare(spring.In(t.Offset())) >= 0 && t.In(chrono.UTC).Compare(autumn) == -1 {
+			return chrono.OffsetOf(2, 0)
+		}
+		return chrono.OffsetOf(1, 0)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		expr     string
+		from     string
+		expected []string
+	}{
+		{
+			name:     "skipped time is shifted forward",
+			expr:     "30 2 * * *",
+			from:     "2026-03-28T12:00:00+01:00",
+			expected: []string{"2026-03-29T03:30:00+02:00", "2026-03-30T02:30:00+02:00"},
+		},
+		{
+			name:     "skipped times do not repeat",
+			expr:     "*/30 * * * *",
+			from:     "2026-03-29T01:30:00+01:00",
+			expected: []string{"2026-03-29T03:00:00+02:00", "2026-03-29T03:30:00+02:00", "2026-03-29T04:00:00+02:00"},
+		},
+		{
+			name:     "repeated time matches once",
+			expr:     "30 2 * * *",
+			from:     "2026-10-24T12:00:00+02:00",
+			expected: []string{"2026-10-25T02:30:00+02:00", "2026-10-26T02:30:00+01:00"},
+		},
+		{
+			name:     "repeated hour",
+			expr:     "0 * * * *",
+			from:     "2026-10-25T01:30:00+02:00",
+			expected: []string{"2026-10-25T02:00:00+02:00", "2026-10-25T03:00:00+01:00"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := chrono.ParseCronExpression(tt.expr)
+			if err != nil {
+				t.Fatalf("failed to parse expression: %v", err)
+			}
+
+			v := parseOffsetDateTime(t, tt.from)
+			for i, expected := range tt.expected {
+				if v, err = c.NextIn(v, offsets); err != nil || v.Format(chrono.ISO8601) != expected {
+					t.Fatalf("c.NextIn() #%d = %v, %v, want %v", i, v.Format(chrono.ISO8601), err, expected)
+				}
+			}
+
+			for i := len(tt.expected) - 2; i >= 0; i-- {
+				if v, err = c.PrevIn(v, offsets); err != nil || v.Format(chrono.ISO8601) != tt.expected[i] {
+					t.Fatalf("c.PrevIn() #%d = %v, %v, want %v", i, v.Format(chrono.ISO8601), err, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func parseOffsetDateTime(t *testing.T, s string) chrono.OffsetDateTime {
+	var out chrono.OffsetDateTime
+	if err := out.Parse(chrono.ISO8601, s); err != nil {
+		t.Fatalf("failed to parse time: %v", err)
+	}
+	return out
+}
@@ -3,7 +3,6 @@ package chrono
 import (
 	"fmt"
 	"math"
-	"math/big"
 )
 
 func getISOWeek(v int64) (isoYear, isoWeek int, err error) {
@@ -152,6 +151,13 @@ func getDaysInYear(year int) int {
 	return 365
 }
 
+func getDaysInMonth(year, month int) int {
+	if month == int(February) && isLeapYear(year) {
+		return 29
+	}
+	return daysInMonths[month-1]
+}
+
 func makeDate(year, month, day int) (int64, error) {
 	if !isDateInBounds(year, month, day) {
 		return 0, fmt.Errorf("date out of bounds")
@@ -212,16 +218,90 @@ func ofISOWeek(year, week, day int) (int64, error) {
 }
 
 func addDateToDate(d int64, years, months, days int) (int64, error) {
+	return addDateToDateWith(d, years, months, days, EndOfMonthOverflow)
+}
+
+// addDateToDateWith adds the years and months to d, resolves a day that does not exist in the resulting month
+// according to eom, and then adds the days.
+func addDateToDateWith(d int64, years, months, days int, eom EndOfMonth) (int64, error) {
 	year, month, day, err := fromDate(d)
 	if err != nil {
 		return 0, err
 	}
 
-	out, err := makeDate(year+years, int(month)+months, day+days)
+	last := getDaysInMonth(year, month)
+	year, month = addMonths(year+years, month, months)
+
+	switch newLast := getDaysInMonth(year, month); eom {
+	case EndOfMonthOverflow:
+	case EndOfMonthClamp:
+		if day > newLast {
+			day = newLast
+		}
+	case EndOfMonthError:
+		if day > newLast {
+			return 0, fmt.Errorf("day %d does not exist in %s %d", day, Month(month), year)
+		}
+	case EndOfMonthStick:
+		if day > newLast || day == last {
+			day = newLast
+		}
+	default:
+		return 0, fmt.Errorf("invalid end-of-month policy %v", eom)
+	}
+
+	out, err := makeDate(year, month, day+days)
 	return out, err
 }
 
+// addMonths adds months to the given year and month, and normalizes the month into the range [1, 12] by carrying whole years.
+func addMonths(year, month, months int) (int, int) {
+	month += months - 1
+	year += month / 12
+	if month %= 12; month < 0 {
+		year--
+		month += 12
+	}
+	return year, month + 1
+}
+
+// periodBetweenDates returns the years, months and days between start and end, where the days are counted
+// from the date reached by adding the whole months to start, with the day clamped to the end of the month if required.
+func periodBetweenDates(start, end int64) (Period, error) {
+	y1, m1, d1, err := fromDate(start)
+	if err != nil {
+		return Period{}, err
+	}
+
+	y2, m2, d2, err := fromDate(end)
+	if err != nil {
+		return Period{}, err
+	}
+
+	months := (y2-y1)*12 + m2 - m1
+	days := int64(d2 - d1)
+	switch {
+	case months > 0 && days < 0:
+		months--
+
+		mid, err := addDateToDateWith(start, 0, months, 0, EndOfMonthClamp)
+		if err != nil {
+			return Period{}, err
+		}
+		days = end - mid
+	case months < 0 && days > 0:
+		months++
+		days -= int64(getDaysInMonth(y2, m2))
+	}
+	return Period{Years: months / 12, Months: months % 12, Days: int(days)}, nil
+}
+
+// simpleDateStr formats the date according to ISO 8601, using the expanded representation
+// of the year (a mandatory sign and at least 4 digits) if the year is outside of the range 0000 to 9999.
 func simpleDateStr(year, month, day int) string {
+	if year < 0 || year > 9999 {
+		return fmt.Sprintf("%+05d-%02d-%02d", year, month, day)
+	}
 	return fmt.Sprintf("%04d-%02d-%02d", year, month, day)
 }
 
@@ -229,62 +309,144 @@ func getISODateSimpleStr(year, week, day int) string {
 	return fmt.Sprintf("%04d-W%02d-%d", year, week, day)
 }
 
-func makeDateTime(date, time int64) big.Int {
-	out := big.NewInt(date)
-	out.Mul(out, bigIntDayExtent)
-	out.Add(out, big.NewInt(time))
-	return *out
+// dateTime is a date, stored in the same manner as LocalDate, combined with a time of day in nanoseconds.
+// The time of day is always normalized to be within the range 0 to 24 hours, so that values can be compared with ==.
+type dateTime struct {
+	date, time int64
+}
+
+func makeDateTime(date, time int64) dateTime {
+	date += time / oneDay
+	if time %= oneDay; time < 0 {
+		date--
+		time += oneDay
+	}
+	return dateTime{date: date, time: time}
 }
 
-func addDurationToBigDate(d big.Int, v Duration) (big.Int, error) {
-	out := new(big.Int).Set(&d)
-	out.Add(out, &v.v)
+func compareDateTimes(d, d2 dateTime) int {
+	switch {
+	case d.date < d2.date:
+		return -1
+	case d.date > d2.date:
+		return 1
+	default:
+		return compareTimes(d.time, d2.time)
+	}
+}
+
+func addDurationToDateTime(d dateTime, v Duration) (dateTime, error) {
+	days, secs := v.secs/86400, v.secs%86400
+	if secs < 0 {
+		days--
+		secs += 86400
+	}
 
-	if out.Cmp(&minLocalDateTime.v) == -1 || out.Cmp(&maxLocalDateTime.v) == 1 {
-		return big.Int{}, fmt.Errorf("datetime out of range")
+	out := makeDateTime(d.date+days, d.time+secs*oneSecond+int64(v.nsec))
+	if compareDateTimes(out, minLocalDateTime.v) == -1 || compareDateTimes(out, maxLocalDateTime.v) == 1 {
+		return dateTime{}, fmt.Errorf("datetime out of range")
 	}
-	return *out, nil
+	return out, nil
 }
 
-func bigDateToOffset(d big.Int, o1, o2 int64) big.Int {
-	out := new(big.Int).Set(&d)
-	out.Sub(out, big.NewInt(o1))
-	out.Add(out, big.NewInt(o2))
-	return *out
+// subDateTimes returns the duration d-u, where the time of d is adjusted by a number of nanoseconds.
+func subDateTimes(d, u dateTime, adjust int64) Duration {
+	out := durationOf(d.time - u.time + adjust)
+	out.secs += (d.date - u.date) * 86400
+	return out
+}
+
+func dateTimeToOffset(d dateTime, o1, o2 int64) dateTime {
+	return makeDateTime(d.date, d.time-o1+o2)
 }
 
-func addDateToBigDate(d big.Int, years, months, days int) (big.Int, error) {
-	date, _ := splitDateAndTime(d)
+func addDateToDateTime(d dateTime, years, months, days int) (dateTime, error) {
+	return addDateToDateTimeWith(d, years, months, days, EndOfMonthOverflow)
+}
 
-	added, err := addDateToDate(date, years, months, days)
+func addDateToDateTimeWith(d dateTime, years, months, days int, eom EndOfMonth) (dateTime, error) {
+	added, err := addDateToDateWith(d.date, years, months, days, eom)
 	if err != nil {
-		return big.Int{}, err
+		return dateTime{}, err
 	}
 
 	if added < minJDN || added > maxJDN {
-		return big.Int{}, fmt.Errorf("date out of bounds")
+		return dateTime{}, fmt.Errorf("date out of bounds")
 	}
+	return dateTime{date: added, time: d.time}, nil
+}
 
-	diff := big.NewInt(int64(added - date))
-	diff.Mul(diff, bigIntDayExtent)
-
-	out := new(big.Int).Set(&d)
-	out.Add(out, diff)
+// periodDurationBetweenDateTimes returns the period between the dates of start and end, as by periodBetweenDates,
+// and the remaining duration, which is always less than 24 hours and has the same sign as the period.
+func periodDurationBetweenDateTimes(start, end dateTime) (Period, Duration, error) {
+	date := end.date
+	switch {
+	case end.date > start.date && end.time < start.time:
+		date--
+	case end.date < start.date && end.time > start.time:
+		date++
+	}
 
-	return *out, nil
+	p, err := periodBetweenDates(start.date, date)
+	if err != nil {
+		return Period{}, Duration{}, err
+	}
+	return p, durationOf(end.time - start.time + (end.date-date)*oneDay), nil
 }
 
-func splitDateAndTime(v big.Int) (date, time int64) {
-	vv := new(big.Int).Set(&v)
+// roundDateTime rounds d to the start of a unit. Units up to and including UnitDay start at multiples of their length since midnight,
+// UnitWeek starts on Monday, and UnitMonth, UnitQuarter and UnitYear start on the first day of the month, quarter or year.
+func roundDateTime(d dateTime, unit Unit, mode roundMode) (dateTime, error) {
+	var start, end dateTime
+	switch unit {
+	case UnitNanosecond, UnitMicrosecond, UnitMillisecond, UnitSecond, UnitMinute, UnitHour, UnitDay:
+		length := int64(unitExtents[unit-1])
+		start = dateTime{date: d.date, time: d.time - d.time%length}
+		end = makeDateTime(start.date, start.time+length)
+	case UnitWeek:
+		start = dateTime{date: d.date - int64(getWeekday(int32(d.date))-int(Monday))}
+		end = dateTime{date: start.date + 7}
+	case UnitMonth, UnitQuarter, UnitYear:
+		year, month, _, err := fromDate(d.date)
+		if err != nil {
+			return dateTime{}, err
+		}
+
+		months := 1
+		switch unit {
+		case UnitQuarter:
+			month -= (month - 1) % 3
+			months = 3
+		case UnitYear:
+			month = int(January)
+			months = 12
+		}
+
+		start = dateTime{date: makeJDN(int64(year), int64(month), 1)}
+		year, month = addMonths(year, month, months)
+		end = dateTime{date: makeJDN(int64(year), int64(month), 1)}
+	default:
+		return dateTime{}, fmt.Errorf("unsupported unit %v", unit)
+	}
+
+	out := start
+	elapsed := (d.date-start.date)*oneDay + d.time - start.time
+	length := (end.date-start.date)*oneDay + end.time - start.time
+	if mode.roundsUp(elapsed, length) {
+		out = end
+	}
 
-	var _time big.Int
-	_date, _ := vv.DivMod(vv, bigIntDayExtent, &_time)
-	return _date.Int64(), _time.Int64()
+	if compareDateTimes(out, minLocalDateTime.v) == -1 || compareDateTimes(out, maxLocalDateTime.v) == 1 {
+		return dateTime{}, fmt.Errorf("datetime out of range")
+	}
+	return out, nil
 }
 
-var (
-	bigIntDayExtent = big.NewInt(24 * int64(Hour))
+func splitDateAndTime(v dateTime) (date, time int64) {
+	return v.date, v.time
+}
 
+var (
 	minLocalDateTime = OfLocalDateTime(MinLocalDate(), LocalTimeOf(0, 0, 0, 0))
 	maxLocalDateTime = OfLocalDateTime(MaxLocalDate(), LocalTimeOf(99, 59, 59, 999999999))
 )
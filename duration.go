@@ -2,14 +2,18 @@ package chrono
 
 import (
 	"fmt"
-	"math/big"
+	"math"
+	"math/bits"
 	"strconv"
+	"strings"
 )
 
 // Duration represents a period of time with nanosecond precision,
 // with a range of approximately ±292,300,000,000 years.
+// Durations are comparable with ==, and can be used as map keys.
 type Duration struct {
-	v big.Int
+	secs int64  // the number of seconds, rounded towards negative infinity
+	nsec uint32 // the nanosecond offset within secs, in the range 0 to 999,999,999
 }
 
 // DurationOf creates a new duration from the supplied extent.
@@ -20,13 +24,29 @@ func DurationOf(v Extent) Duration {
 }
 
 func durationOf(v int64) Duration {
-	return Duration{v: *big.NewInt(v)}
+	secs, nsec := v/nsecPerSecond, v%nsecPerSecond
+	if nsec < 0 {
+		secs--
+		nsec += nsecPerSecond
+	}
+	return Duration{secs: secs, nsec: uint32(nsec)}
 }
 
 // Compare compares d with d2. If d is less than d2, it returns -1;
 // if d is greater than d2, it returns 1; if they're equal, it returns 0.
 func (d Duration) Compare(d2 Duration) int {
-	return d.v.Cmp(&d2.v)
+	switch {
+	case d.secs < d2.secs:
+		return -1
+	case d.secs > d2.secs:
+		return 1
+	case d.nsec < d2.nsec:
+		return -1
+	case d.nsec > d2.nsec:
+		return 1
+	default:
+		return 0
+	}
 }
 
 // Add returns the duration d+d2.
@@ -47,59 +67,314 @@ func (d Duration) CanAdd(d2 Duration) bool {
 }
 
 func (d Duration) add(d2 Duration) (Duration, error) {
-	out := new(big.Int).Set(&d.v)
-	out.Add(out, &d2.v)
+	nsec := d.nsec + d2.nsec
+	secs, under, over := addInt64(d.secs, d2.secs)
+	if nsec >= nsecPerSecond {
+		nsec -= nsecPerSecond
+
+		var over2 bool
+		secs, _, over2 = addInt64(secs, 1)
+		over = over || over2
+	}
 
-	if out.Cmp(bigIntMinInt64) == -1 || out.Cmp(bigIntMaxInt64) == 1 {
+	if under || over {
 		return Duration{}, fmt.Errorf("duration out of range")
 	}
-	return Duration{v: *out}, nil
+	return Duration{secs: secs, nsec: nsec}, nil
+}
+
+// Sub returns the duration d-d2.
+// If the operation would overflow the maximum duration, or underflow the minimum duration, it panics.
+// Use CanSub to test whether a panic would occur.
+func (d Duration) Sub(d2 Duration) Duration {
+	out, err := d.sub(d2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanSub returns false if Sub would panic if passed the same argument.
+func (d Duration) CanSub(d2 Duration) bool {
+	_, err := d.sub(d2)
+	return err == nil
+}
+
+func (d Duration) sub(d2 Duration) (Duration, error) {
+	if d2 == MinDuration() { // MinDuration cannot be negated, so subtract it in two steps
+		out, err := d.add(MaxDuration())
+		if err != nil {
+			return Duration{}, err
+		}
+		return out.add(Duration{nsec: 1})
+	}
+
+	neg, _ := d2.neg()
+	return d.add(neg)
+}
+
+// Neg returns the duration -d.
+// It panics if d is MinDuration, which is the only duration that cannot be negated.
+// Use CanNeg to test whether a panic would occur.
+func (d Duration) Neg() Duration {
+	out, err := d.neg()
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanNeg returns false if Neg would panic.
+func (d Duration) CanNeg() bool {
+	_, err := d.neg()
+	return err == nil
+}
+
+func (d Duration) neg() (Duration, error) {
+	neg, secs, nsec := d.abs()
+	return durationFromAbs(!neg, secs, nsec)
+}
+
+// Abs returns the absolute value of d.
+// It panics if d is MinDuration, whose absolute value is greater than MaxDuration.
+// Use CanAbs to test whether a panic would occur.
+func (d Duration) Abs() Duration {
+	out, err := d.absolute()
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanAbs returns false if Abs would panic.
+func (d Duration) CanAbs() bool {
+	_, err := d.absolute()
+	return err == nil
+}
+
+func (d Duration) absolute() (Duration, error) {
+	_, secs, nsec := d.abs()
+	return durationFromAbs(false, secs, nsec)
+}
+
+// Mul returns the duration d*v.
+// If the operation would overflow the maximum duration, or underflow the minimum duration, it panics.
+// Use CanMul to test whether a panic would occur.
+func (d Duration) Mul(v int64) Duration {
+	out, err := d.mul(v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanMul returns false if Mul would panic if passed the same argument.
+func (d Duration) CanMul(v int64) bool {
+	_, err := d.mul(v)
+	return err == nil
 }
 
 func (d Duration) mul(v int64) (Duration, error) {
-	out := new(big.Int).Set(&d.v)
-	out.Mul(out, big.NewInt(v))
+	return d.mulRat(v, 1)
+}
 
-	if out.Cmp(bigIntMinInt64) == -1 || out.Cmp(bigIntMaxInt64) == 1 {
+// MulRat returns the duration d*num/den, truncated toward zero to the nearest nanosecond.
+// The result is exact, even where the intermediate product d*num would not be representable.
+// If den is zero, or if the operation would overflow the maximum duration, or underflow the minimum duration, it panics.
+// Use CanMulRat to test whether a panic would occur.
+func (d Duration) MulRat(num, den int64) Duration {
+	out, err := d.mulRat(num, den)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanMulRat returns false if MulRat would panic if passed the same arguments.
+func (d Duration) CanMulRat(num, den int64) bool {
+	_, err := d.mulRat(num, den)
+	return err == nil
+}
+
+func (d Duration) mulRat(num, den int64) (Duration, error) {
+	if den == 0 {
+		return Duration{}, fmt.Errorf("division by zero")
+	}
+
+	neg, v := d.nanos()
+	if (num < 0) != (den < 0) {
+		neg = !neg
+	}
+
+	// d*num/den = (d/den)*num + (d%den)*num/den, which avoids overflowing the intermediate product.
+	q, r := v.div64(absInt64(den))
+	out, over := q.mul64(absInt64(num))
+	rem, _ := uint128{lo: r}.mul64(absInt64(num))
+	rem, _ = rem.div64(absInt64(den))
+
+	out, over2 := out.add(rem)
+	if over || over2 {
+		return Duration{}, fmt.Errorf("duration out of range")
+	}
+	return durationFromNanos(neg, out)
+}
+
+// Div returns the quotient d/d2, truncated toward zero, and the remainder, which has the same sign as d.
+// If d2 is zero, or if the quotient would overflow int64, it panics.
+// Use CanDiv to test whether a panic would occur.
+func (d Duration) Div(d2 Duration) (quotient int64, remainder Duration) {
+	quotient, remainder, err := d.div(d2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return quotient, remainder
+}
+
+// CanDiv returns false if Div would panic if passed the same argument.
+func (d Duration) CanDiv(d2 Duration) bool {
+	_, _, err := d.div(d2)
+	return err == nil
+}
+
+func (d Duration) div(d2 Duration) (int64, Duration, error) {
+	if d2 == (Duration{}) {
+		return 0, Duration{}, fmt.Errorf("division by zero")
+	}
+
+	neg, v := d.nanos()
+	neg2, v2 := d2.nanos()
+
+	q, r := v.div(v2)
+	rem, _ := durationFromNanos(neg, r)
+
+	switch {
+	case q.hi != 0:
+	case neg == neg2 && q.lo <= math.MaxInt64:
+		return int64(q.lo), rem, nil
+	case neg != neg2 && q.lo <= 1<<63:
+		return int64(-q.lo), rem, nil
+	}
+	return 0, Duration{}, fmt.Errorf("quotient out of range")
+}
+
+// Truncate returns the result of rounding d toward zero to a multiple of m.
+// If m <= 0, Truncate returns d unchanged.
+func (d Duration) Truncate(m Extent) Duration {
+	if m <= 0 {
+		return d
+	}
+
+	neg, v := d.nanos()
+	_, r := v.div64(uint64(m))
+	out, _ := durationFromNanos(neg, v.sub(uint128{lo: r}))
+	return out
+}
+
+// Round returns the result of rounding d to the nearest multiple of m, with halfway values rounded away from zero.
+// If m <= 0, Round returns d unchanged.
+// If the result would overflow the maximum duration, or underflow the minimum duration, it panics.
+// Use CanRound to test whether a panic would occur.
+func (d Duration) Round(m Extent) Duration {
+	out, err := d.round(m)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanRound returns false if Round would panic if passed the same argument.
+func (d Duration) CanRound(m Extent) bool {
+	_, err := d.round(m)
+	return err == nil
+}
+
+func (d Duration) round(m Extent) (Duration, error) {
+	if m <= 0 {
+		return d, nil
+	}
+
+	neg, v := d.nanos()
+	_, r := v.div64(uint64(m))
+
+	out := v.sub(uint128{lo: r})
+	if r >= uint64(m)-r {
+		out, _ = out.add(uint128{lo: uint64(m)})
+	}
+	return durationFromNanos(neg, out)
+}
+
+// nanos returns the sign and the magnitude of d as a number of nanoseconds.
+func (d Duration) nanos() (neg bool, v uint128) {
+	neg, secs, nsec := d.abs()
+	v, _ = uint128{lo: secs}.mul64(nsecPerSecond)
+	v, _ = v.add(uint128{lo: uint64(nsec)})
+	return neg, v
+}
+
+// durationFromNanos returns the duration of the specified sign and magnitude in nanoseconds.
+func durationFromNanos(neg bool, v uint128) (Duration, error) {
+	if v.hi >= nsecPerSecond {
+		return Duration{}, fmt.Errorf("duration out of range")
+	}
+
+	secs, nsec := bits.Div64(v.hi, v.lo, nsecPerSecond)
+	return durationFromAbs(neg, secs, uint32(nsec))
+}
+
+// abs returns the sign and the magnitude of d as a number of seconds and nanoseconds.
+func (d Duration) abs() (neg bool, secs uint64, nsec uint32) {
+	switch {
+	case d.secs >= 0:
+		return false, uint64(d.secs), d.nsec
+	case d.nsec == 0:
+		return true, uint64(-d.secs), 0
+	default:
+		return true, uint64(-(d.secs + 1)), nsecPerSecond - d.nsec
+	}
+}
+
+// durationFromAbs returns the duration of the specified sign and magnitude.
+func durationFromAbs(neg bool, secs uint64, nsec uint32) (Duration, error) {
+	switch {
+	case !neg && secs <= math.MaxInt64:
+		return Duration{secs: int64(secs), nsec: nsec}, nil
+	case neg && nsec == 0 && secs <= 1<<63:
+		return Duration{secs: int64(-secs)}, nil
+	case neg && nsec != 0 && secs < 1<<63:
+		return Duration{secs: -int64(secs) - 1, nsec: nsecPerSecond - nsec}, nil
+	default:
 		return Duration{}, fmt.Errorf("duration out of range")
 	}
-	return Duration{v: *out}, nil
 }
 
 // Nanoseconds returns the duration as a floating point number of nanoseconds.
 func (d Duration) Nanoseconds() float64 {
-	out, _ := new(big.Float).SetInt(&d.v).Float64()
-	return out
+	return float64(d.secs)*1e9 + float64(d.nsec)
 }
 
 // Microseconds returns the duration as a floating point number of microseconds.
 func (d Duration) Microseconds() float64 {
-	out, _ := new(big.Float).Quo(new(big.Float).SetInt(&d.v), bigFloatMicrosecondExtent).Float64()
-	return out
+	return float64(d.secs)*1e6 + float64(d.nsec)/1e3
 }
 
 // Milliseconds returns the duration as a floating point number of milliseconds.
 func (d Duration) Milliseconds() float64 {
-	out, _ := new(big.Float).Quo(new(big.Float).SetInt(&d.v), bigFloatMillisecondExtent).Float64()
-	return out
+	return float64(d.secs)*1e3 + float64(d.nsec)/1e6
 }
 
 // Seconds returns the duration as a floating point number of seconds.
 func (d Duration) Seconds() float64 {
-	out, _ := new(big.Float).Quo(new(big.Float).SetInt(&d.v), bigFloatSecondExtent).Float64()
-	return out
+	return float64(d.secs) + float64(d.nsec)/1e9
 }
 
 // Minutes returns the duration as a floating point number of minutes.
 func (d Duration) Minutes() float64 {
-	out, _ := new(big.Float).Quo(new(big.Float).SetInt(&d.v), bigFloatMinuteExtent).Float64()
-	return out
+	return float64(d.secs)/60 + float64(d.nsec)/6e10
 }
 
 // Hours returns the duration as a floating point number of hours.
 func (d Duration) Hours() float64 {
-	out, _ := new(big.Float).Quo(new(big.Float).SetInt(&d.v), bigFloatHourExtent).Float64()
-	return out
+	return float64(d.secs)/3600 + float64(d.nsec)/3.6e12
 }
 
 // String returns a string formatted according to ISO 8601.
@@ -110,7 +385,7 @@ func (d Duration) String() string {
 
 // Units returns the whole numbers of hours, minutes, seconds, and nanosecond offset represented by d.
 func (d Duration) Units() (hours, mins, secs, nsec int) {
-	_secs, _nsec, _ := d.integers()
+	_, _secs, _nsec := d.abs()
 	hours = int(_secs / 3600)
 	mins = int((_secs / 60) % 60)
 	secs = int(_secs % 60)
@@ -152,7 +427,7 @@ func (d Duration) Format(exclusive ...Designator) string {
 }
 
 func (d Duration) format(exclusive ...Designator) (_ string, neg bool) {
-	secs, nsec, neg := d.integers()
+	neg, secs, nsec := d.abs()
 	return formatDuration(secs, nsec, neg, exclusive...)
 }
 
@@ -161,7 +436,7 @@ var (
 	Prec = -1
 )
 
-func formatDuration(secs int64, nsec uint32, neg bool, exclusive ...Designator) (_ string, isNeg bool) {
+func formatDuration(secs uint64, nsec uint32, neg bool, exclusive ...Designator) (_ string, isNeg bool) {
 	values := make(map[Designator]float64, 3)
 	if len(exclusive) >= 1 {
 		for _, d := range exclusive {
@@ -234,54 +509,272 @@ func formatDuration(secs int64, nsec uint32, neg bool, exclusive ...Designator)
 	return out, neg
 }
 
-func (d Duration) integers() (secs int64, nsec uint32, neg bool) {
-	v := new(big.Int).Abs(&d.v)
-	var _nsec big.Int
-	_secs, _ := new(big.Int).DivMod(v, bigIntSecondExtent, &_nsec)
-	return _secs.Int64(), uint32(_nsec.Uint64()), d.v.Cmp(bigIntNegOne) == -1
-}
-
 // Parse the time portion of an ISO 8601 duration.
 func (d *Duration) Parse(s string) error {
-	_, _, _, _, secs, nsec, neg, err := parseDuration(s, false, true)
+	_, secs, nsec, neg, err := parseDuration(s, false, true)
 	if err != nil {
 		return err
 	}
 
-	*d = makeDuration(secs, nsec, neg)
+	out, err := makeDuration(secs, nsec, neg)
+	if err != nil {
+		return err
+	}
+	*d = out
 	return nil
 }
 
-// MinDuration returns the minimum supported duration.
-func MinDuration() Duration {
-	return Duration{v: *bigIntMinInt64}
+// FormatGo returns d formatted in the syntax of the standard library's time.Duration, e.g. 1h30m0s.
+// The syntax is extended such that durations of 24 hours or more begin with a number of days, e.g. 2d1h30m0s,
+// where a day is exactly 24 hours. Durations of less than one second use a smaller unit, e.g. 1.5ms.
+// The output can be parsed by ParseGo.
+func (d Duration) FormatGo() string {
+	neg, v := d.nanos()
+
+	var out string
+	if neg {
+		out = "-"
+	}
+
+	switch {
+	case v.hi == 0 && v.lo == 0:
+		return "0s"
+	case v.hi == 0 && v.lo < uint64(Microsecond):
+		return out + strconv.FormatUint(v.lo, 10) + "ns"
+	case v.hi == 0 && v.lo < uint64(Millisecond):
+		return out + formatGoDecimal(v.lo, uint64(Microsecond), 3) + "µs"
+	case v.hi == 0 && v.lo < uint64(Second):
+		return out + formatGoDecimal(v.lo, uint64(Millisecond), 6) + "ms"
+	}
+
+	_, secs, nsec := d.abs()
+	days, hours, mins := secs/86400, (secs/3600)%24, (secs/60)%60
+	if days != 0 {
+		out += strconv.FormatUint(days, 10) + "d"
+	}
+
+	if days != 0 || hours != 0 {
+		out += strconv.FormatUint(hours, 10) + "h"
+	}
+
+	if days != 0 || hours != 0 || mins != 0 {
+		out += strconv.FormatUint(mins, 10) + "m"
+	}
+	return out + formatGoDecimal((secs%60)*nsecPerSecond+uint64(nsec), nsecPerSecond, 9) + "s"
 }
 
-// MaxDuration returns the maximum supported duration.
-func MaxDuration() Duration {
-	return Duration{v: *bigIntMaxInt64}
+// formatGoDecimal formats v/unit as a decimal number, where unit is 10^digits, trimming any trailing zeros of the fraction.
+func formatGoDecimal(v, unit uint64, digits int) string {
+	out := strconv.FormatUint(v/unit, 10)
+	if frac := v % unit; frac != 0 {
+		out += "." + strings.TrimRight(fmt.Sprintf("%0*d", digits, frac), "0")
+	}
+	return out
+}
+
+// ParseGo parses a duration in the syntax of the standard library's time.ParseDuration, e.g. 1h30m or -1.5h,
+// and stores the value it represents in d.
+// The syntax is extended to also accept a number of days, where a day is exactly 24 hours, e.g. 2d1h30m.
+// Valid units are "ns", "us" (or "µs"), "ms", "s", "m", "h", and "d".
+// The result is exact to the nanosecond, with any smaller fractions truncated.
+func (d *Duration) ParseGo(s string) error {
+	orig := s
+
+	var neg bool
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	if s == "0" {
+		*d = Duration{}
+		return nil
+	} else if s == "" {
+		return fmt.Errorf("invalid duration %q", orig)
+	}
+
+	var total uint128
+	for s != "" {
+		i := countDigits(s)
+		integer := s[:i]
+		s = s[i:]
+
+		var fraction string
+		if s != "" && s[0] == '.' {
+			i = countDigits(s[1:])
+			fraction = s[1 : 1+i]
+			s = s[1+i:]
+		}
+
+		if integer == "" && fraction == "" {
+			return fmt.Errorf("invalid duration %q", orig)
+		}
+
+		i = strings.IndexAny(s, ".0123456789")
+		if i == -1 {
+			i = len(s)
+		}
+
+		unit, ok := goDurationUnits[s[:i]]
+		if !ok {
+			return fmt.Errorf("unknown or missing unit in duration %q", orig)
+		}
+		s = s[i:]
+
+		var v uint128
+		var over bool
+		for _, c := range integer {
+			if v, over = v.mul64(10); over {
+				return fmt.Errorf("duration %q out of range", orig)
+			}
+			v, _ = v.add(uint128{lo: uint64(c - '0')})
+		}
+
+		if v.hi != 0 {
+			return fmt.Errorf("duration %q out of range", orig)
+		}
+
+		if v, over = v.mul64(unit); over {
+			return fmt.Errorf("duration %q out of range", orig)
+		}
+
+		v, _ = v.add(uint128{lo: uint64(parseDecimalFraction(fraction, int64(unit)))})
+		if total, over = total.add(v); over {
+			return fmt.Errorf("duration %q out of range", orig)
+		}
+	}
+
+	out, err := durationFromNanos(neg, total)
+	if err != nil {
+		return fmt.Errorf("duration %q out of range", orig)
+	}
+
+	*d = out
+	return nil
 }
 
-func makeDuration(secs int64, nsec uint32, neg bool) Duration {
-	out := new(big.Int).Mul(big.NewInt(secs), bigIntSecondExtent)
-	out.Add(out, big.NewInt(int64(nsec)))
+func countDigits(s string) int {
+	var n int
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	return n
+}
+
+var goDurationUnits = map[string]uint64{
+	"ns": 1,
+	"us": uint64(Microsecond),
+	"µs": uint64(Microsecond), // U+00B5 micro sign
+	"μs": uint64(Microsecond), // U+03BC Greek letter mu
+	"ms": uint64(Millisecond),
+	"s":  uint64(Second),
+	"m":  uint64(Minute),
+	"h":  uint64(Hour),
+	"d":  uint64(24 * Hour),
+}
+
+// HumanFormat configures the output of Duration.FormatHuman.
+type HumanFormat struct {
+	// Largest is the largest unit used, such that greater amounts are expressed as multiples of it,
+	// e.g. 36 hours rather than 1 day, 12 hours if Largest is UnitHour. The default, which is also used
+	// if Largest is not a valid Unit, is UnitDay. Units larger than UnitWeek do not have a fixed length, and are treated as UnitWeek.
+	Largest Unit
+	// Smallest is the smallest unit used. Any remainder is truncated, or rounded if Round is true.
+	// The default, which is also used if Smallest is not a valid Unit, is UnitSecond.
+	// A unit larger than Largest is treated as Largest.
+	Smallest Unit
+	// MaxUnits limits the number of units used, counted from the largest non-zero unit,
+	// e.g. 1 hour, 30 minutes rather than 1 hour, 30 minutes, 15 seconds if MaxUnits is 2.
+	// Units with a value of 0 are omitted from the output, but are still counted. The default of 0 applies no limit.
+	MaxUnits int
+	// Round causes the remainder to be rounded to the nearest multiple of the smallest unit used,
+	// with halfway values rounded away from zero, rather than truncated.
+	Round bool
+}
+
+// FormatHuman returns d formatted as a human-readable list of units, e.g. 1 hour, 30 minutes, according to f.
+// Units with a value of 0 are omitted, except that a duration of 0 is formatted as 0 of the smallest unit, e.g. 0 seconds.
+// Negative durations are preceded by '-'.
+func (d Duration) FormatHuman(f HumanFormat) string {
+	largest, smallest := f.Largest, f.Smallest
+	if largest < UnitNanosecond || largest > UnitYear {
+		largest = UnitDay
+	} else if largest > UnitWeek {
+		largest = UnitWeek
+	}
+
+	if smallest < UnitNanosecond || smallest > UnitYear {
+		smallest = UnitSecond
+	}
+
+	if smallest > largest {
+		smallest = largest
+	}
+
+	neg, v := d.nanos()
+
+	last := smallest
+	if f.MaxUnits > 0 {
+		for u := largest; u > smallest; u-- {
+			if v.cmp(uint128{lo: unitExtents[u-1]}) >= 0 {
+				if u-Unit(f.MaxUnits)+1 > smallest {
+					last = u - Unit(f.MaxUnits) + 1
+				}
+				break
+			}
+		}
+	}
+
+	q, r := v.div64(unitExtents[last-1])
+	if f.Round && r >= unitExtents[last-1]-r {
+		q, _ = q.add(uint128{lo: 1})
+	}
+	v, _ = q.mul64(unitExtents[last-1])
+
+	var parts []string
+	for u := largest; u >= last; u-- {
+		n, rem := v.div64(unitExtents[u-1])
+		v = uint128{lo: rem}
+
+		if n != (uint128{}) {
+			parts = append(parts, formatHumanUnit(n, u))
+		}
+	}
+
+	if len(parts) == 0 {
+		return formatHumanUnit(uint128{}, last)
+	}
+
+	out := strings.Join(parts, ", ")
 	if neg {
-		out.Neg(out)
+		out = "-" + out
 	}
-	return Duration{v: *out}
+	return out
 }
 
-var (
-	bigIntNegOne = big.NewInt(-1)
+func formatHumanUnit(n uint128, u Unit) string {
+	if n == (uint128{lo: 1}) {
+		return "1 " + u.String()
+	}
+	return n.String() + " " + u.String() + "s"
+}
 
-	bigIntMinInt64 = new(big.Int).Lsh(big.NewInt(int64(-Second)), 63)
-	bigIntMaxInt64 = new(big.Int).Add(new(big.Int).Lsh(big.NewInt(int64(Second)), 63), big.NewInt(-1))
+// MinDuration returns the minimum supported duration.
+func MinDuration() Duration {
+	return Duration{secs: math.MinInt64}
+}
 
-	bigIntSecondExtent = big.NewInt(int64(Second))
+// MaxDuration returns the maximum supported duration.
+func MaxDuration() Duration {
+	return Duration{secs: math.MaxInt64, nsec: nsecPerSecond - 1}
+}
 
-	bigFloatMicrosecondExtent = big.NewFloat(float64(Microsecond))
-	bigFloatMillisecondExtent = big.NewFloat(float64(Millisecond))
-	bigFloatSecondExtent      = big.NewFloat(float64(Second))
-	bigFloatMinuteExtent      = big.NewFloat(float64(Minute))
-	bigFloatHourExtent        = big.NewFloat(float64(Hour))
-)
+func makeDuration(secs int64, nsec uint32, neg bool) (Duration, error) {
+	if secs < 0 {
+		return Duration{}, fmt.Errorf("duration out of range")
+	}
+	return durationFromAbs(neg, uint64(secs)+uint64(nsec/nsecPerSecond), nsec%nsecPerSecond)
+}
+
+// nsecPerSecond is the untyped equivalent of oneSecond.
+const nsecPerSecond = 1000000000
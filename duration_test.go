@@ -1,10 +1,12 @@
 package chrono_test
 
 import (
+	"math"
 	"reflect"
 	"runtime"
 	"strings"
 	"testing"
+	stdtime "time"
 
 	"github.com/go-chrono/chrono"
 )
@@ -186,6 +188,9 @@ func TestDuration_Compare(t *testing.T) {
 		{"nanos less", chrono.DurationOf(1 * chrono.Nanosecond), chrono.DurationOf(2 * chrono.Nanosecond), -1},
 		{"nanos more", chrono.DurationOf(2 * chrono.Nanosecond), chrono.DurationOf(1 * chrono.Nanosecond), 1},
 		{"equal", chrono.DurationOf(chrono.Minute), chrono.DurationOf(chrono.Minute), 0},
+		{"negative nanos less", chrono.DurationOf(-2 * chrono.Nanosecond), chrono.DurationOf(-1 * chrono.Nanosecond), -1},
+		{"negative and positive", chrono.DurationOf(-1 * chrono.Nanosecond), chrono.DurationOf(0), -1},
+		{"min and max", chrono.MinDuration(), chrono.MaxDuration(), -1},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			if v := tt.d.Compare(tt.d2); v != tt.expected {
@@ -195,6 +200,21 @@ func TestDuration_Compare(t *testing.T) {
 	}
 }
 
+func TestDuration_comparable(t *testing.T) {
+	if chrono.DurationOf(chrono.Second) != chrono.DurationOf(1000*chrono.Millisecond) {
+		t.Error("equal durations are not ==")
+	}
+
+	if chrono.DurationOf(-1*chrono.Second).Add(chrono.DurationOf(chrono.Second)) != (chrono.Duration{}) {
+		t.Error("zero duration is not == to the zero value")
+	}
+
+	m := map[chrono.Duration]bool{chrono.DurationOf(90 * chrono.Minute): true}
+	if !m[chrono.DurationOf(chrono.Hour).Add(chrono.DurationOf(30*chrono.Minute))] {
+		t.Error("duration not found in map")
+	}
+}
+
 func TestDuration_Add(t *testing.T) {
 	for _, tt := range []struct {
 		name     string
@@ -314,6 +334,195 @@ func TestDuration_Add(t *testing.T) {
 	}
 }
 
+func TestDuration_Sub(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		d1       chrono.Duration
+		d2       chrono.Duration
+		expected chrono.Duration
+	}{
+		{"positive", chrono.DurationOf(2 * chrono.Hour), chrono.DurationOf(90 * chrono.Minute), chrono.DurationOf(30 * chrono.Minute)},
+		{"negative result", chrono.DurationOf(250 * chrono.Millisecond), chrono.DurationOf(chrono.Second), chrono.DurationOf(-750 * chrono.Millisecond)},
+		{"minus negative", chrono.DurationOf(chrono.Second), chrono.DurationOf(-1 * chrono.Nanosecond), chrono.DurationOf(chrono.Second + chrono.Nanosecond)},
+		{"min from negative", chrono.DurationOf(-1 * chrono.Nanosecond), chrono.MinDuration(), chrono.MaxDuration()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.d1.CanSub(tt.d2) {
+				t.Error("d1.CanSub(d2) = false, want true")
+			}
+
+			if out := tt.d1.Sub(tt.d2); out != tt.expected {
+				t.Errorf("d1.Sub(d2) = %s, want %s", out, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name string
+		d1   chrono.Duration
+		d2   chrono.Duration
+	}{
+		{"overflow", chrono.MaxDuration(), chrono.DurationOf(-1 * chrono.Nanosecond)},
+		{"underflow", chrono.MinDuration(), chrono.DurationOf(1 * chrono.Nanosecond)},
+		{"min from zero", chrono.Duration{}, chrono.MinDuration()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.d1.CanSub(tt.d2) {
+				t.Error("d1.CanSub(d2) = true, want false")
+			}
+		})
+	}
+}
+
+func TestDuration_Neg(t *testing.T) {
+	if out := chrono.DurationOf(1500 * chrono.Millisecond).Neg(); out != chrono.DurationOf(-1500*chrono.Millisecond) {
+		t.Errorf("d.Neg() = %s, want %s", out, chrono.DurationOf(-1500*chrono.Millisecond))
+	}
+
+	if out := chrono.DurationOf(-1500 * chrono.Millisecond).Abs(); out != chrono.DurationOf(1500*chrono.Millisecond) {
+		t.Errorf("d.Abs() = %s, want %s", out, chrono.DurationOf(1500*chrono.Millisecond))
+	}
+
+	if out := (chrono.Duration{}).Neg(); out != (chrono.Duration{}) {
+		t.Errorf("d.Neg() = %s, want %s", out, chrono.Duration{})
+	}
+
+	if d := chrono.MaxDuration(); !d.CanNeg() || !d.CanAbs() {
+		t.Errorf("d.CanNeg() = %t, d.CanAbs() = %t, want true, true", d.CanNeg(), d.CanAbs())
+	}
+
+	if d := chrono.MinDuration(); d.CanNeg() || d.CanAbs() {
+		t.Errorf("d.CanNeg() = %t, d.CanAbs() = %t, want false, false", d.CanNeg(), d.CanAbs())
+	}
+
+	for name, f := range map[string]func(chrono.Duration) chrono.Duration{
+		"Neg": chrono.Duration.Neg,
+		"Abs": chrono.Duration.Abs,
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic that didn't occur")
+				}
+			}()
+
+			f(chrono.MinDuration())
+		})
+	}
+}
+
+func TestDuration_Mul(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		d        chrono.Duration
+		num, den int64
+		expected chrono.Duration
+	}{
+		{"integer", chrono.DurationOf(1500 * chrono.Millisecond), 3, 1, chrono.DurationOf(4500 * chrono.Millisecond)},
+		{"negative integer", chrono.DurationOf(1500 * chrono.Millisecond), -3, 1, chrono.DurationOf(-4500 * chrono.Millisecond)},
+		{"fraction", chrono.DurationOf(chrono.Hour), 2, 3, chrono.DurationOf(40 * chrono.Minute)},
+		{"truncated fraction", chrono.DurationOf(chrono.Second), 1, 3, chrono.DurationOf(333333333 * chrono.Nanosecond)},
+		{"negative truncated fraction", chrono.DurationOf(-1 * chrono.Second), 1, 3, chrono.DurationOf(-333333333 * chrono.Nanosecond)},
+		{"large intermediate", chrono.MaxDuration(), math.MaxInt64, math.MaxInt64, chrono.MaxDuration()},
+		{"max negated", chrono.MaxDuration(), -1, 1, chrono.MinDuration().Add(chrono.DurationOf(chrono.Nanosecond))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.den == 1 {
+				if out := tt.d.Mul(tt.num); out != tt.expected {
+					t.Errorf("d.Mul(%d) = %s, want %s", tt.num, out, tt.expected)
+				}
+			}
+
+			if out := tt.d.MulRat(tt.num, tt.den); out != tt.expected {
+				t.Errorf("d.MulRat(%d, %d) = %s, want %s", tt.num, tt.den, out, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name     string
+		d        chrono.Duration
+		num, den int64
+	}{
+		{"overflow", chrono.MaxDuration(), 2, 1},
+		{"underflow", chrono.MinDuration(), 3, 2},
+		{"negated min", chrono.MinDuration(), -1, 1},
+		{"division by zero", chrono.DurationOf(chrono.Second), 1, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.d.CanMulRat(tt.num, tt.den) {
+				t.Errorf("d.CanMulRat(%d, %d) = true, want false", tt.num, tt.den)
+			}
+		})
+	}
+}
+
+func TestDuration_Div(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		d1        chrono.Duration
+		d2        chrono.Duration
+		quotient  int64
+		remainder chrono.Duration
+	}{
+		{"exact", chrono.DurationOf(chrono.Hour), chrono.DurationOf(15 * chrono.Minute), 4, chrono.Duration{}},
+		{"remainder", chrono.DurationOf(100 * chrono.Minute), chrono.DurationOf(chrono.Hour), 1, chrono.DurationOf(40 * chrono.Minute)},
+		{"negative dividend", chrono.DurationOf(-100 * chrono.Minute), chrono.DurationOf(chrono.Hour), -1, chrono.DurationOf(-40 * chrono.Minute)},
+		{"negative divisor", chrono.DurationOf(100 * chrono.Minute), chrono.DurationOf(-1 * chrono.Hour), -1, chrono.DurationOf(40 * chrono.Minute)},
+		{"large divisor", chrono.MaxDuration(), chrono.MinDuration(), 0, chrono.MaxDuration()},
+		{"large dividend", chrono.MinDuration(), chrono.DurationOf(-1 * chrono.Hour), 2562047788015215, chrono.DurationOf(-30*chrono.Minute - 8*chrono.Second)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			quotient, remainder := tt.d1.Div(tt.d2)
+			if quotient != tt.quotient {
+				t.Errorf("quotient = %d, want %d", quotient, tt.quotient)
+			}
+
+			if remainder != tt.remainder {
+				t.Errorf("remainder = %s, want %s", remainder, tt.remainder)
+			}
+		})
+	}
+
+	if chrono.DurationOf(chrono.Second).CanDiv(chrono.Duration{}) {
+		t.Error("d.CanDiv(0) = true, want false")
+	}
+
+	if chrono.MaxDuration().CanDiv(chrono.DurationOf(chrono.Nanosecond)) {
+		t.Error("d.CanDiv(1ns) = true, want false")
+	}
+}
+
+func TestDuration_Round(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		d         chrono.Duration
+		m         chrono.Extent
+		truncated chrono.Duration
+		rounded   chrono.Duration
+	}{
+		{"down", chrono.DurationOf(1400 * chrono.Millisecond), chrono.Second, chrono.DurationOf(chrono.Second), chrono.DurationOf(chrono.Second)},
+		{"halfway", chrono.DurationOf(1500 * chrono.Millisecond), chrono.Second, chrono.DurationOf(chrono.Second), chrono.DurationOf(2 * chrono.Second)},
+		{"negative halfway", chrono.DurationOf(-1500 * chrono.Millisecond), chrono.Second, chrono.DurationOf(-1 * chrono.Second), chrono.DurationOf(-2 * chrono.Second)},
+		{"minutes", chrono.DurationOf(100 * chrono.Minute), 45 * chrono.Minute, chrono.DurationOf(90 * chrono.Minute), chrono.DurationOf(90 * chrono.Minute)},
+		{"zero multiple", chrono.DurationOf(1500 * chrono.Millisecond), 0, chrono.DurationOf(1500 * chrono.Millisecond), chrono.DurationOf(1500 * chrono.Millisecond)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.d.Truncate(tt.m); out != tt.truncated {
+				t.Errorf("d.Truncate(m) = %s, want %s", out, tt.truncated)
+			}
+
+			if out := tt.d.Round(tt.m); out != tt.rounded {
+				t.Errorf("d.Round(m) = %s, want %s", out, tt.rounded)
+			}
+		})
+	}
+
+	if chrono.MaxDuration().CanRound(chrono.Hour) {
+		t.Error("d.CanRound(1h) = true, want false")
+	}
+}
+
 func TestDuration_Format(t *testing.T) {
 	for _, tt := range formatDurationCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -339,6 +548,16 @@ func TestDuration_Format(t *testing.T) {
 	}
 }
 
+func TestDuration_Format_bounds(t *testing.T) {
+	if out := chrono.MaxDuration().String(); out != "PT2562047788015215H30M7.999999999S" {
+		t.Errorf("chrono.MaxDuration().String() = %s", out)
+	}
+
+	if out := chrono.MinDuration().String(); out != "-PT2562047788015215H30M8S" {
+		t.Errorf("chrono.MinDuration().String() = %s", out)
+	}
+}
+
 func TestDuration_Parse(t *testing.T) {
 	for _, tt := range parseDurationCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -387,6 +606,117 @@ func TestDuration_Parse(t *testing.T) {
 	})
 }
 
+func TestDuration_FormatGo(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		d        chrono.Duration
+		expected string
+	}{
+		{"zero", chrono.Duration{}, "0s"},
+		{"nanoseconds", chrono.DurationOf(5 * chrono.Nanosecond), "5ns"},
+		{"microseconds", chrono.DurationOf(1500 * chrono.Nanosecond), "1.5µs"},
+		{"milliseconds", chrono.DurationOf(1500 * chrono.Microsecond), "1.5ms"},
+		{"seconds", chrono.DurationOf(1500 * chrono.Millisecond), "1.5s"},
+		{"minutes", chrono.DurationOf(90 * chrono.Second), "1m30s"},
+		{"hours", chrono.DurationOf(90 * chrono.Minute), "1h30m0s"},
+		{"days", chrono.DurationOf(49*chrono.Hour + 30*chrono.Minute + chrono.Nanosecond), "2d1h30m0.000000001s"},
+		{"negative", chrono.DurationOf(-90 * chrono.Minute), "-1h30m0s"},
+		{"min", chrono.MinDuration(), "-106751991167300d15h30m8s"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			out := tt.d.FormatGo()
+			if out != tt.expected {
+				t.Errorf("d.FormatGo() = %q, want %q", out, tt.expected)
+			}
+
+			var d chrono.Duration
+			if err := d.ParseGo(out); err != nil {
+				t.Errorf("failed to parse duration: %v", err)
+			} else if d != tt.d {
+				t.Errorf("parsed duration = %s, want %s", d, tt.d)
+			}
+		})
+	}
+
+	for _, e := range []chrono.Extent{0, 1, 999, 1000, 1001, 1500000, 999999999, chrono.Second, 59 * chrono.Minute, 23*chrono.Hour + 1, -90 * chrono.Minute} {
+		if out, expected := chrono.DurationOf(e).FormatGo(), stdtime.Duration(e).String(); out != expected {
+			t.Errorf("DurationOf(%d).FormatGo() = %q, want %q", e, out, expected)
+		}
+	}
+}
+
+func TestDuration_ParseGo(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		expected chrono.Duration
+	}{
+		{"0", chrono.Duration{}},
+		{"+5s", chrono.DurationOf(5 * chrono.Second)},
+		{"1h30m", chrono.DurationOf(90 * chrono.Minute)},
+		{"-1.5h", chrono.DurationOf(-90 * chrono.Minute)},
+		{".5m", chrono.DurationOf(30 * chrono.Second)},
+		{"1.s", chrono.DurationOf(chrono.Second)},
+		{"1d2h", chrono.DurationOf(26 * chrono.Hour)},
+		{"1.5d", chrono.DurationOf(36 * chrono.Hour)},
+		{"3us2μs1µs", chrono.DurationOf(6 * chrono.Microsecond)},
+		{"1.0000000019s", chrono.DurationOf(chrono.Second + chrono.Nanosecond)},
+		{"106751991167300d15h30m7.999999999s", chrono.MaxDuration()},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			var d chrono.Duration
+			if err := d.ParseGo(tt.input); err != nil {
+				t.Errorf("failed to parse duration: %v", err)
+			} else if d != tt.expected {
+				t.Errorf("parsed duration = %s, want %s", d, tt.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "-", "1", "s", "1x", "1.h.5", "106751991167300d15h30m8s", "99999999999999999999999h"} {
+		t.Run(input, func(t *testing.T) {
+			var d chrono.Duration
+			if err := d.ParseGo(input); err == nil {
+				t.Errorf("expecting error parsing %q but got nil", input)
+			}
+		})
+	}
+}
+
+func TestDuration_FormatHuman(t *testing.T) {
+	d := chrono.DurationOf(49*chrono.Hour + 30*chrono.Minute + 45*chrono.Second + 500*chrono.Millisecond)
+
+	for _, tt := range []struct {
+		name     string
+		d        chrono.Duration
+		format   chrono.HumanFormat
+		expected string
+	}{
+		{"default", d, chrono.HumanFormat{}, "2 days, 1 hour, 30 minutes, 45 seconds"},
+		{"largest", d, chrono.HumanFormat{Largest: chrono.UnitHour}, "49 hours, 30 minutes, 45 seconds"},
+		{"smallest", d, chrono.HumanFormat{Smallest: chrono.UnitMillisecond}, "2 days, 1 hour, 30 minutes, 45 seconds, 500 milliseconds"},
+		{"max units", d, chrono.HumanFormat{MaxUnits: 2}, "2 days, 1 hour"},
+		{"max units with zeros", chrono.DurationOf(chrono.Hour + 5*chrono.Second), chrono.HumanFormat{MaxUnits: 2}, "1 hour"},
+		{"round", d, chrono.HumanFormat{Round: true}, "2 days, 1 hour, 30 minutes, 46 seconds"},
+		{"round with carry", chrono.DurationOf(59*chrono.Minute + 40*chrono.Second), chrono.HumanFormat{MaxUnits: 1, Round: true}, "1 hour"},
+		{"weeks", chrono.DurationOf(15 * 24 * chrono.Hour), chrono.HumanFormat{Largest: chrono.UnitYear}, "2 weeks, 1 day"},
+		{"zero", chrono.Duration{}, chrono.HumanFormat{}, "0 seconds"},
+		{"truncated to zero", chrono.DurationOf(500 * chrono.Millisecond), chrono.HumanFormat{}, "0 seconds"},
+		{"negative", chrono.DurationOf(-90 * chrono.Minute), chrono.HumanFormat{}, "-1 hour, 30 minutes"},
+		{"max microseconds", chrono.MaxDuration(), chrono.HumanFormat{Largest: chrono.UnitMicrosecond}, "9223372036854775807999999 microseconds"},
+		{"max nanoseconds", chrono.MaxDuration(), chrono.HumanFormat{Largest: chrono.UnitNanosecond}, "9223372036854775807999999999 nanoseconds"},
+		{"min nanoseconds", chrono.MinDuration(), chrono.HumanFormat{Largest: chrono.UnitNanosecond}, "-9223372036854775808000000000 nanoseconds"},
+		{"invalid smallest", d, chrono.HumanFormat{Smallest: chrono.Unit(-3)}, "2 days, 1 hour, 30 minutes, 45 seconds"},
+		{"invalid largest", d, chrono.HumanFormat{Largest: chrono.Unit(12)}, "2 days, 1 hour, 30 minutes, 45 seconds"},
+		{"smallest larger than largest", d, chrono.HumanFormat{Largest: chrono.UnitMinute, Smallest: chrono.UnitYear}, "2970 minutes"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.d.FormatHuman(tt.format); out != tt.expected {
+				t.Errorf("d.FormatHuman() = %q, want %q", out, tt.expected)
+			}
+		})
+	}
+}
+
 func TestDuration_Units(t *testing.T) {
 	d := chrono.DurationOf(12*chrono.Hour + 34*chrono.Minute + 56*chrono.Second + 7*chrono.Nanosecond)
 
@@ -407,3 +737,37 @@ func TestDuration_Units(t *testing.T) {
 		t.Errorf("expecting 7 nsecs, got %d", nsec)
 	}
 }
+
+func BenchmarkDuration_Add(b *testing.B) {
+	d1 := chrono.DurationOf(90 * chrono.Minute)
+	d2 := chrono.DurationOf(1500 * chrono.Millisecond)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d1.Add(d2)
+	}
+}
+
+func BenchmarkDuration_Compare(b *testing.B) {
+	d1 := chrono.DurationOf(90 * chrono.Minute)
+	d2 := chrono.DurationOf(1500 * chrono.Millisecond)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d1.Compare(d2)
+	}
+}
+
+func BenchmarkDuration_Seconds(b *testing.B) {
+	d := chrono.DurationOf(90*chrono.Minute + 1500*chrono.Millisecond)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.Seconds()
+	}
+}
+
+func BenchmarkDuration_Format(b *testing.B) {
+	d := chrono.DurationOf(90*chrono.Minute + 1500*chrono.Millisecond)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = d.Format()
+	}
+}
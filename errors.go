@@ -1,7 +1,22 @@
 package chrono
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrUnsupportedRepresentation indicates that the requested value
 // cannot be represented, or that the requested value is not present.
 var ErrUnsupportedRepresentation = errors.ErrUnsupported
+
+// ErrEmptyInterval indicates that the result of an operation on intervals would be empty,
+// such as the intersection of two intervals that do not overlap.
+var ErrEmptyInterval = errors.New("empty interval")
+
+// ErrOpenEndpoint indicates that the requested start or end of an interval is open, such that the interval extends indefinitely.
+// It wraps [ErrUnsupportedRepresentation].
+var ErrOpenEndpoint = fmt.Errorf("open endpoint: %w", ErrUnsupportedRepresentation)
+
+// ErrUnknownEndpoint indicates that the requested start or end of an interval exists but is not known.
+// It wraps [ErrUnsupportedRepresentation].
+var ErrUnknownEndpoint = fmt.Errorf("unknown endpoint: %w", ErrUnsupportedRepresentation)
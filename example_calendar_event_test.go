@@ -0,0 +1,23 @@
+package chrono_test
+
+import (
+	"fmt"
+
+	"github.com/go-chrono/chrono"
+)
+
+func ExampleCalendarEvent_Next() {
+	e, _ := chrono.ParseCalendarEvent("Mon..Fri 9:00 Europe/Berlin")
+	fmt.Println(e)
+
+	t := chrono.OffsetDateTimeOf(2026, chrono.March, 26, 12, 0, 0, 0, 0, 0)
+	for i := 0; i < 3; i++ {
+		t, _ = e.Next(t)
+		fmt.Println(t)
+	}
+	// Output:
+	// Mon..Fri *-*-* 09:00:00 Europe/Berlin
+	// 2026-03-27 09:00:00+01:00
+	// 2026-03-30 09:00:00+02:00
+	// 2026-03-31 09:00:00+02:00
+}
@@ -0,0 +1,21 @@
+package chrono_test
+
+import (
+	"fmt"
+
+	"github.com/go-chrono/chrono"
+)
+
+func ExampleCronExpression_Next() {
+	c, _ := chrono.ParseCronExpression("0 17 LW * ?")
+
+	t := chrono.OffsetDateTimeOf(2026, chrono.January, 15, 12, 0, 0, 0, 0, 0)
+	for i := 0; i < 3; i++ {
+		t, _ = c.Next(t)
+		fmt.Println(t)
+	}
+	// Output:
+	// 2026-01-30 17:00:00Z
+	// 2026-02-27 17:00:00Z
+	// 2026-03-31 17:00:00Z
+}
@@ -0,0 +1,32 @@
+package chrono_test
+
+import (
+	"fmt"
+
+	"github.com/go-chrono/chrono"
+)
+
+func ExampleParseICalendarPeriod() {
+	i, _ := chrono.ParseICalendarPeriod("TZID=Europe/London:20261017T090000/PT1H30M")
+
+	start, _ := i.Start()
+	end, _ := i.End()
+	fmt.Println(start, end)
+
+	s, _ := chrono.FormatICalendarPeriod(i)
+	fmt.Println(s)
+	// Output:
+	// 2026-10-17 09:00:00+01:00 2026-10-17 10:30:00+01:00
+	// 20261017T080000Z/PT1H30M
+}
+
+func ExampleParseICalendarDuration() {
+	p, d, _ := chrono.ParseICalendarDuration("-P1W")
+	fmt.Println(p.Weeks, d)
+
+	s, _ := chrono.FormatICalendarDuration(chrono.Period{Days: 1}, chrono.DurationOf(90*chrono.Minute))
+	fmt.Println(s)
+	// Output:
+	// -1 PT0S
+	// P1DT1H30M
+}
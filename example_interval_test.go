@@ -16,3 +16,30 @@ func ExampleParseInterval() {
 	fmt.Printf("start: %v; duration: %v; end: %v; repetitions: %v", s, chrono.FormatDuration(p, d), e, i.Repetitions())
 	// Output: start: 2007-03-01 13:00:00Z; duration: P1Y2M10DT2H30M; end: 2008-05-11 15:30:00Z; repetitions: 5
 }
+
+func ExampleInterval_Cursor() {
+	i, _ := chrono.ParseInterval("R3/2026-01-01T09:00:00Z/P1W")
+
+	c, _ := i.Cursor()
+	for c.Next() {
+		fmt.Println(c.Start(), "to", c.End())
+	}
+	// Output:
+	// 2026-01-01 09:00:00Z to 2026-01-08 09:00:00Z
+	// 2026-01-08 09:00:00Z to 2026-01-15 09:00:00Z
+	// 2026-01-15 09:00:00Z to 2026-01-22 09:00:00Z
+}
+
+func ExampleInterval_Split() {
+	i, _ := chrono.ParseInterval("2026-01-15T10:00:00Z/2026-03-02T08:00:00Z")
+
+	months, _ := i.Split(chrono.UnitMonth, chrono.UTC)
+	for _, m := range months {
+		_, d, _ := m.Duration()
+		fmt.Println(m, d)
+	}
+	// Output:
+	// 2026-01-15T10:00:00Z/2026-02-01T00:00:00Z PT398H
+	// 2026-02-01T00:00:00Z/2026-03-01T00:00:00Z PT672H
+	// 2026-03-01T00:00:00Z/2026-03-02T08:00:00Z PT32H
+}
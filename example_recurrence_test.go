@@ -0,0 +1,21 @@
+package chrono_test
+
+import (
+	"fmt"
+
+	"github.com/go-chrono/chrono"
+)
+
+func ExampleRecurrenceRule_Cursor() {
+	r, _ := chrono.ParseRecurrenceRule("FREQ=MONTHLY;COUNT=4;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1")
+
+	c, _ := r.Cursor(chrono.LocalDateTimeOf(2026, chrono.January, 30, 17, 0, 0, 0), nil, nil)
+	for c.Next() {
+		fmt.Println(c.LocalDateTime())
+	}
+	// Output:
+	// 2026-01-30 17:00:00
+	// 2026-02-27 17:00:00
+	// 2026-03-31 17:00:00
+	// 2026-04-30 17:00:00
+}
@@ -93,8 +93,11 @@ func (e Extent) String() string {
 // Format the extent according to ISO 8601.
 // Behaves the same as Duration.Format.
 func (e Extent) Format(exclusive ...Designator) string {
-	abs := extentAbs(int64(e))
-	out, neg := formatDuration(abs/oneSecond, uint32(abs%oneSecond), e < 0, exclusive...)
+	abs := uint64(e)
+	if e < 0 {
+		abs = -abs
+	}
+	out, neg := formatDuration(abs/uint64(oneSecond), uint32(abs%uint64(oneSecond)), e < 0, exclusive...)
 	out = "P" + out
 	if neg {
 		out = "-" + out
@@ -105,7 +108,7 @@ func (e Extent) Format(exclusive ...Designator) string {
 // Parse the time portion of an ISO 8601 duration.
 // Behaves the same as Duration.Parse.
 func (e *Extent) Parse(s string) error {
-	_, _, _, _, secs, nsec, neg, err := parseDuration(s, false, true)
+	_, secs, nsec, neg, err := parseDuration(s, false, true)
 	if err != nil {
 		return err
 	}
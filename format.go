@@ -4,12 +4,17 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // These are predefined layouts used for the parsing and formatting of dates, times and date-times.
 // Additional layouts can be composed using the specifiers detailed below:
 //
 //   - %Y:  The ISO 8601 year as a decimal number, padded to 4 digits with leading 0s.
+//   - %nY: The ISO 8601 expanded year as a decimal number, preceded always by the sign ('+' or '-'), and padded to 4+n digits with leading 0s,
+//     where n is the number of additional digits (1 to 9) agreed for the expanded representation, e.g. %2Y for ±YYYYYY. See note (13).
+//     The String methods of the date types use the expanded representation for years outside of the range 0000 to 9999,
+//     with as many digits as required, e.g. +10000 or -0044.
 //   - %EY: The year in the era as a decimal number, padded to 4 digits with leading 0s.
 //   - %y:  The ISO 8601 year without a century as a decimal number, padded to 2 digits with a leading 0, in the range 00 to 99. See note (1).
 //   - %Ey: The year in the era without a century as a decimal number, padded to 2 digits with a leading 0, in the range 00 to 99. See notes (1) and (9).
@@ -30,6 +35,7 @@ import (
 // Week numbers:
 //
 //   - %G: The ISO 8601 week-based year, padded to 4 digits with leading 0s. This may differ by ±1 to the actual calendar year. See note (2).
+//   - %nG: The ISO 8601 week-based year in the expanded representation, in the same manner as %nY. See notes (2) and (13).
 //   - %V: The ISO week number, padded to 2 digits with a leading 0, in the range 01 to 53. See note (2).
 //
 // Times of day:
@@ -44,12 +50,18 @@ import (
 //   - %M: The minute as a decimal number, padded to 2 digits with a leading 0, in the range 00 to 59.
 //   - %S: The second as a decimal number, padded to 2 digits with a leading 0, in the range 00 to 59.
 //
+// Decimal fractions of time components:
+//
+//   - %EH: Equivalent to %H, followed by the decimal fraction of the hour, e.g. 10.5 for 10:30. See notes (11) and (12).
+//   - %EM: Equivalent to %M, followed by the decimal fraction of the minute, e.g. 30.25 for 30 minutes and 15 seconds. See notes (11) and (12).
+//
 // Millisecond precisions:
 //
 //   - %f:  Equivalent to %6f.
 //   - %3f: The millisecond offset within the represented second, rounded either up or down and padded to 3 digits with leading 0s.
 //   - %6f: The microsecond offset within the represented second, rounded either up or down and padded to 6 digits with leading 0s.
-//   - %9f: The nanosecond offset within the represented second, padded to 9 digits with leading 0s.
+//   - %9f: The nanosecond offset within the represented second, padded to 9 digits with leading 0s. See note (14).
+//   - %nf: More generally, the fraction of the represented second to n digits (1 to 9), truncated and padded with leading 0s.
 //
 // Time offsets:
 //
@@ -58,9 +70,13 @@ import (
 //
 // When formatting using specifiers that represent padded decimals, leading 0s can be omitted using the '-' character after the '%'.
 // For example, '%m' may produce the string '04' (for March), but '%-m' produces '4'.
-// However, when parsing using these specifiers, it is not required that the input string contains any leading zeros.
+// However, when parsing using these specifiers, it is not required that the input string contains any leading zeros,
+// unless ParseExactWidth is used.
+//
+// When parsing using specifiers that represent textual values (e.g. month names, etc.), the input text is treated case insensitively,
+// unless ParseExactCase is used.
 //
-// When parsing using specifiers that represent textual values (e.g. month names, etc.), the input text is treated case insensitively.
+// The behavior of parsing can be made stricter or more lenient by passing a ParseOption to the ParseWith functions.
 //
 // Depending on the context in which the layout is used, only a subset of specifiers may be supported by a particular function.
 // For example, %H is not supported when parsing or formatting a date.
@@ -101,6 +117,17 @@ import (
 //     an error will be returned if the represented years to not match.
 //  10. When parsing era names (%EC), 'AD' and 'BC' are accepted in place of 'CE' and 'BCE',
 //     although only the latter are used to format.
+//  11. When formatting decimal fractions (%EH and %EM), the number of decimal places can be fixed to between 1 and 9 digits
+//     by including the precision before the 'E', e.g. %3EH. Otherwise, up to 9 decimal places are formatted,
+//     trailing 0s are omitted, and the decimal mark is omitted entirely if the fraction is 0.
+//     The fraction is always truncated, and never rounded up, so that it never carries into a preceding component.
+//     When parsing, the decimal fraction is optional and may contain any number of decimal places.
+//  12. When parsing, either '.' or ',' is accepted as the decimal mark of %EH and %EM,
+//     and a '.' or ',' that immediately precedes %f in the layout matches either character.
+//  13. When parsing expanded years (%nY or %nG), the sign is mandatory, and at most 4+n digits are consumed.
+//     Since %Y consumes at most 4 digits, the ISO 8601 layouts cannot parse a year after 9999 as formatted by String,
+//     such as +10000-01-01, which must instead be parsed using %nY with enough digits, e.g. %1Y-%m-%d.
+//  14. When parsing %9f, any digits after the first 9 are consumed and discarded, truncating the fraction to nanoseconds.
 const (
 	// ISO 8601.
 	ISO8601                          = ISO8601DateTimeExtended
@@ -114,6 +141,9 @@ const (
 	ISO8601TimeTruncatedMinsSimple   = "T%H%M"                                   // T0304
 	ISO8601TimeTruncatedMinsExtended = "T%H:%M"                                  // T03:04
 	ISO8601TimeTruncatedHours        = "T%H"                                     // T03
+	ISO8601TimeDecimalHours          = "T%EH"                                    // T03.068055555
+	ISO8601TimeDecimalMinsSimple     = "T%H%EM"                                  // T0304.083333333
+	ISO8601TimeDecimalMinsExtended   = "T%H:%EM"                                 // T03:04.083333333
 	ISO8601DateTimeSimple            = ISO8601DateSimple + ISO8601TimeSimple     // 20060102T030405-0700
 	ISO8601DateTimeExtended          = ISO8601DateExtended + ISO8601TimeExtended // 2006-01-02T03:04:05-07:00
 	ISO8601WeekSimple                = "%GW%V"                                   // 2006W01
@@ -135,6 +165,7 @@ func formatDateTimeOffset(layout string, date *int32, time *int64, offset *int64
 		hour  int
 		min   int
 		sec   int
+		nsec  int
 	)
 
 	var err error
@@ -147,7 +178,7 @@ func formatDateTimeOffset(layout string, date *int32, time *int64, offset *int64
 
 	if time != nil {
 		v := int64(*time)
-		hour, min, sec, _ = fromTime(v)
+		hour, min, sec, nsec = fromTime(v)
 	}
 
 	var buf, out []rune
@@ -206,18 +237,27 @@ NextChar:
 					out = append(out, []rune(decimal(divideAndRoundInt(nanos, 1000), 6))...)
 				case 9: // %9f
 					out = append(out, []rune(decimal(nanos, 9))...)
-				default:
-					panic(fmt.Sprintf("unsupported specifier '%df'", precision))
+				default: // %nf
+					out = append(out, []rune(decimal(nanos/int(pow10(9-precision)), int(precision)))...)
 				}
-			case date != nil && main == 'G': // %G
+			case date != nil && main == 'G':
 				v := int64(*date)
 				y, _, err := getISOWeek(v)
 				if err != nil {
 					panic(err.Error())
 				}
-				out = append(out, []rune(decimal(y, 4))...)
-			case time != nil && main == 'H': // %H
+
+				if precision != 0 { // %nG
+					out = append(out, []rune(formatExpandedYear(y, precision, nopad))...)
+				} else { // %G
+					out = append(out, []rune(decimal(y, 4))...)
+				}
+			case time != nil && main == 'H':
 				out = append(out, []rune(decimal(hour, 2))...)
+				if localed { // %EH
+					rem := int64(min)*oneMinute + int64(sec)*oneSecond + int64(nsec)
+					out = append(out, []rune(formatDecimalFraction(rem, oneHour, precision))...)
+				}
 			case time != nil && main == 'I': // %I
 				h, _ := convert24To12HourClock(hour)
 				out = append(out, []rune(decimal(h, 2))...)
@@ -230,8 +270,12 @@ NextChar:
 				out = append(out, []rune(decimal(d, 3))...)
 			case date != nil && main == 'm': // %m
 				out = append(out, []rune(decimal(int(month), 2))...)
-			case time != nil && main == 'M': // %M
+			case time != nil && main == 'M':
 				out = append(out, []rune(decimal(min, 2))...)
+				if localed { // %EM
+					rem := int64(sec)*oneSecond + int64(nsec)
+					out = append(out, []rune(formatDecimalFraction(rem, oneMinute, precision))...)
+				}
 			case time != nil && main == 'p': // %p
 				if _, isAfternoon := convert24To12HourClock(hour); !isAfternoon {
 					out = append(out, []rune("AM")...)
@@ -261,7 +305,15 @@ NextChar:
 					y, _ = convertISOToGregorianYear(y)
 				}
 				out = append(out, []rune(decimal(y%100, 2))...)
-			case date != nil && main == 'Y': // %Y
+			case date != nil && main == 'Y':
+				if precision != 0 { // %nY
+					if localed {
+						return "", fmt.Errorf("unsupported sequence %q", string(buf))
+					}
+					out = append(out, []rune(formatExpandedYear(year, precision, nopad))...)
+					break
+				}
+
 				y := year
 				if localed { // %EY
 					y, _ = convertISOToGregorianYear(y)
@@ -343,6 +395,35 @@ type parts struct {
 	offset int64
 }
 
+// ParseOption is a set of flags that modify the behavior of parsing.
+// Flags can be combined using the bitwise OR operator, e.g. ParseExactWidth|ParseExactCase.
+type ParseOption uint
+
+// Parse options.
+const (
+	// ParseExactWidth requires that decimals are present with exactly the number of digits to which they are padded when formatted,
+	// unless the '-' modifier is used, and that UTC offsets (%z and %Ez) include minutes. See note (7).
+	ParseExactWidth ParseOption = 1 << iota
+	// ParseExactCase requires that textual values (e.g. month names, etc.) appear in the same case as they are formatted.
+	ParseExactCase
+	// ParseStrictUTC requires that an offset of +0000 is represented as 'Z' only by %Ez, and not by %z. See note (8).
+	ParseStrictUTC
+	// ParseFlexibleSpace allows any sequence of whitespace characters in the layout to match any sequence,
+	// including an empty sequence, of whitespace characters in the parsed text.
+	ParseFlexibleSpace
+	// ParseOptionalSeparators allows the separator characters '-', ':', '/', '.' and ',' that appear in the layout
+	// to be absent from the parsed text.
+	ParseOptionalSeparators
+	// ParseTrailingText allows text that follows the parsed value to be present, rather than returning an error.
+	// The number of bytes consumed by the parsed value is returned by the ParseWith functions.
+	ParseTrailingText
+
+	// ParseStrict is a strict mode of parsing, suitable for the validation of input.
+	ParseStrict = ParseExactWidth | ParseExactCase | ParseStrictUTC
+	// ParseLenient is a lenient mode of parsing, suitable for the extraction of values from unstructured text.
+	ParseLenient = ParseFlexibleSpace | ParseOptionalSeparators | ParseTrailingText
+)
+
 // parseDateAndTime parses the supplied value according to the specified layout.
 // date, time and offset must be provided in order for those components to be parsed.
 // If not provided, and the specifiers that pertain to those components are
@@ -350,16 +431,23 @@ type parts struct {
 // If non-zero, date, time, and offset and taken as starting points, where the individual values
 // that they represent are replaced only if present in the supplied layout.
 func parseDateAndTime(layout, value string, date, time, offset *int64) error {
+	_, err := parseDateAndTimeWith(layout, value, 0, date, time, offset)
+	return err
+}
+
+// parseDateAndTimeWith behaves the same as parseDateAndTime, but modified according to opts,
+// and additionally returns the number of bytes of value that were consumed.
+func parseDateAndTimeWith(layout, value string, opts ParseOption, date, time, offset *int64) (int, error) {
 	var parts parts
 
 	var err error
 	if date != nil {
 		if parts.year, parts.month, parts.day, err = fromDate(*date); err != nil {
-			return err
+			return 0, err
 		}
 
 		if parts.isoYear, parts.isoWeek, err = getISOWeek(*date); err != nil {
-			return err
+			return 0, err
 		}
 	}
 
@@ -380,30 +468,65 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				return nil
 			}
 
-			verify := func() error {
-				if !strings.HasPrefix(value[pos:], string(buf)) {
-					return fmt.Errorf("parsing time \"%s\" as \"%s\": cannot parse \"%s\" as \"%s\"", value, layout, value[pos:], string(buf))
+			verify := func() (int, error) {
+				text := string(buf)
+				cannotParse := fmt.Errorf("parsing time \"%s\" as \"%s\": cannot parse \"%s\" as \"%s\"", value, layout, value[pos:], string(buf))
+
+				// Accept either decimal mark before a fraction according to note (12).
+				if n := len(text); isDecimalMark(text[n-1]) && isFractionSpecifier(layout[i:]) &&
+					len(value[pos:]) >= n && isDecimalMark(value[pos+n-1]) {
+					text = text[:n-1] + value[pos+n-1:pos+n]
 				}
-				return nil
+
+				if opts&(ParseFlexibleSpace|ParseOptionalSeparators) == 0 {
+					if !strings.HasPrefix(value[pos:], text) {
+						return 0, cannotParse
+					}
+					return len(buf), nil
+				}
+
+				j := pos
+				for k := 0; k < len(text); k++ {
+					switch c := text[k]; {
+					case opts&ParseFlexibleSpace != 0 && isSpace(c):
+						for k+1 < len(text) && isSpace(text[k+1]) {
+							k++
+						}
+
+						for j < len(value) && isSpace(value[j]) {
+							j++
+						}
+					case j < len(value) && value[j] == c:
+						j++
+					case opts&ParseOptionalSeparators != 0 && isSeparator(c):
+					default:
+						return 0, cannotParse
+					}
+				}
+				return j - pos, nil
+			}
+
+			n, err := verify()
+			if err != nil {
+				return err
 			}
 
 			if buf[len(buf)-1] == '%' {
-				if err := verify(); err != nil {
-					return err
-				}
 				buf = []rune{'%'}
-			} else {
-				if err := verify(); err != nil {
-					return err
-				}
+				n = len(buf)
 			}
 
-			pos += len(buf)
+			pos += n
 			buf = nil
 			return nil
 		}
 
 		processSpecifier := func() error {
+			nopad, localed, precision, main, err := parseSpecifier(buf)
+			if err != nil {
+				return err
+			}
+
 			integer := func(maxLen int) (int, error) {
 				var neg bool
 
@@ -419,6 +542,7 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 					}
 				}
 
+				width := maxLen
 				if l := len(str); l == 0 {
 					return 0, fmt.Errorf(endOfStringErrMsg, value)
 				} else if l < maxLen {
@@ -428,7 +552,7 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 
 				var i int
 				for _, char := range str {
-					if (char < '0' || char > '9') && char != '.' && char != ',' {
+					if char < '0' || char > '9' {
 						break
 					}
 					i++
@@ -437,6 +561,8 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 
 				if i == 0 {
 					return 0, fmt.Errorf(extraTextErrMsg, value, str)
+				} else if opts&ParseExactWidth != 0 && !nopad && i != width {
+					return 0, fmt.Errorf("parsing time \"%s\": expecting %d digits, got %q", value, width, str[:i])
 				}
 
 				out, err := strconv.Atoi(str[:i])
@@ -450,10 +576,36 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				return out, nil
 			}
 
+			fraction := func(unit int64) (int64, error) {
+				str := value[pos:]
+				if len(str) == 0 || !isDecimalMark(str[0]) {
+					return 0, nil
+				}
+
+				i := 1
+				for i < len(str) && str[i] >= '0' && str[i] <= '9' {
+					i++
+				}
+
+				if i == 1 {
+					return 0, fmt.Errorf(extraTextErrMsg, value, str)
+				}
+				pos += i
+
+				return parseDecimalFraction(str[1:i], unit), nil
+			}
+
 			hasMore := func() bool {
 				return len(value[pos:]) > 0
 			}
 
+			expandedYear := func(extraDigits uint) (int, error) {
+				if !hasMore() || (value[pos] != '+' && value[pos] != '-') {
+					return 0, fmt.Errorf("parsing time \"%s\": expanded year must begin with '+' or '-'", value)
+				}
+				return integer(4 + int(extraDigits))
+			}
+
 			casedAlpha := func(char rune) (rune, bool) {
 				str := value[pos:]
 				if len(str) != 0 {
@@ -496,9 +648,12 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				return string(_lower[:i]), string(_original[:i])
 			}
 
-			_, localed, precision, main, err := parseSpecifier(buf)
-			if err != nil {
-				return err
+			// exactCase checks the case of textual values when ParseExactCase is used.
+			exactCase := func(original, expected string) error {
+				if opts&ParseExactCase != 0 && original != expected {
+					return fmt.Errorf("parsing time \"%s\": %q does not match the case of %q", value, original, expected)
+				}
+				return nil
 			}
 
 			switch {
@@ -507,24 +662,32 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				var ok bool
 				if parts.dayOfWeek, ok = shortDayNameLookup[lower]; !ok {
 					return fmt.Errorf("unrecognized short day name %q", original)
+				} else if err := exactCase(original, shortWeekdayName(parts.dayOfWeek)); err != nil {
+					return err
 				}
 			case date != nil && main == 'A': // %A
 				lower, original := alphas(9)
 				var ok bool
 				if parts.dayOfWeek, ok = longDayNameLookup[lower]; !ok {
 					return fmt.Errorf("unrecognized day name %q", original)
+				} else if err := exactCase(original, longWeekdayName(parts.dayOfWeek)); err != nil {
+					return err
 				}
 			case date != nil && main == 'b': // %b
 				lower, original := alphas(3)
 				var ok bool
 				if parts.month, ok = shortMonthNameLookup[lower]; !ok {
 					return fmt.Errorf("unrecognized short month name %q", original)
+				} else if err := exactCase(original, shortMonthName(parts.month)); err != nil {
+					return err
 				}
 			case date != nil && main == 'B': // %B
 				lower, original := alphas(9)
 				var ok bool
 				if parts.month, ok = longMonthNameLookup[lower]; !ok {
 					return fmt.Errorf("unrecognized month name %q", original)
+				} else if err := exactCase(original, longMonthName(parts.month)); err != nil {
+					return err
 				}
 			case date != nil && main == 'C':
 				if localed { // %EC
@@ -538,6 +701,10 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 					default:
 						return fmt.Errorf("unrecognized era %q", original)
 					}
+
+					if err := exactCase(original, strings.ToUpper(lower)); err != nil {
+						return err
+					}
 				} else { // %C
 					var v int
 					if v, err = integer(2); err != nil {
@@ -573,17 +740,38 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 					if parts.nsec, err = integer(9); err != nil {
 						return err
 					}
-				default:
+
+					for pos < len(value) && value[pos] >= '0' && value[pos] <= '9' {
+						pos++
+					}
+				default: // %nf
+					v, err := integer(int(precision))
+					if err != nil {
+						return err
+					}
+					parts.nsec = v * int(pow10(9-precision))
 				}
-			case date != nil && main == 'G': // %G
+			case date != nil && main == 'G':
 				parts.haveISODate = true
-				if parts.isoYear, err = integer(4); err != nil {
+				if precision != 0 { // %nG
+					if parts.isoYear, err = expandedYear(precision); err != nil {
+						return err
+					}
+				} else if parts.isoYear, err = integer(4); err != nil { // %G
 					return err
 				}
-			case time != nil && main == 'H': // %H
+			case time != nil && main == 'H':
 				if parts.hour, err = integer(2); err != nil {
 					return err
 				}
+
+				if localed { // %EH
+					var v int64
+					if v, err = fraction(oneHour); err != nil {
+						return err
+					}
+					_, parts.min, parts.sec, parts.nsec = extentUnits(v)
+				}
 			case time != nil && main == 'I': // %I
 				parts.have12HourClock = true
 				if parts.hour, err = integer(2); err != nil {
@@ -597,10 +785,18 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				if parts.month, err = integer(2); err != nil {
 					return err
 				}
-			case time != nil && main == 'M': // %M
+			case time != nil && main == 'M':
 				if parts.min, err = integer(2); err != nil {
 					return err
 				}
+
+				if localed { // %EM
+					var v int64
+					if v, err = fraction(oneMinute); err != nil {
+						return err
+					}
+					_, _, parts.sec, parts.nsec = extentUnits(v)
+				}
 			case time != nil && main == 'p': // %p
 				lower, original := alphas(2)
 				switch strings.ToUpper(lower) {
@@ -610,6 +806,10 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				default:
 					return fmt.Errorf("failed to parse time of day %q", original)
 				}
+
+				if err := exactCase(original, strings.ToUpper(lower)); err != nil {
+					return err
+				}
 			case time != nil && main == 'P': // %P
 				lower, original := alphas(2)
 				switch lower {
@@ -619,6 +819,10 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				default:
 					return fmt.Errorf("failed to parse time of day %q", original)
 				}
+
+				if err := exactCase(original, lower); err != nil {
+					return err
+				}
 			case time != nil && main == 'S': // %S
 				if parts.sec, err = integer(2); err != nil {
 					return err
@@ -643,7 +847,19 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				}
 				parts.shortYear = &v
 				parts.yearType = -1
-			case date != nil && main == 'Y': // %Y
+			case date != nil && main == 'Y':
+				if precision != 0 { // %nY
+					if localed {
+						return fmt.Errorf("unsupported sequence %q", string(buf))
+					}
+
+					if parts.year, err = expandedYear(precision); err != nil {
+						return err
+					}
+					parts.yearType = 1
+					break
+				}
+
 				if localed { // %EY
 					parts.haveGregorianYear = true
 				}
@@ -663,10 +879,12 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				var h, m int
 				var err error
 
-				// Catch the 'Z' case, which is valid for both %z and %Ez.
+				// Catch the 'Z' case, which is valid for both %z and %Ez, unless ParseStrictUTC is used.
 				// Continue instead of breaking because offset may need updating.
-				if _, ok := casedAlpha('Z'); ok {
-					goto CalculateOffset
+				if localed || opts&ParseStrictUTC == 0 {
+					if _, ok := casedAlpha('Z'); ok {
+						goto CalculateOffset
+					}
 				}
 
 				if h, err = integer(2); err != nil {
@@ -674,6 +892,9 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				}
 
 				if !hasMore() {
+					if opts&ParseExactWidth != 0 {
+						return fmt.Errorf(endOfStringErrMsg, value)
+					}
 					goto CalculateOffset
 				}
 
@@ -726,12 +947,12 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 				}
 
 				if err := processSpecifier(); err != nil {
-					return err
+					return pos, err
 				}
 				goto AppendToBuffer
 			} else if isText && c == '%' {
 				if err := verifyText(); err != nil {
-					return err
+					return pos, err
 				}
 				goto AppendToBuffer
 			}
@@ -740,20 +961,20 @@ func parseDateAndTime(layout, value string, date, time, offset *int64) error {
 			buf = append(buf, rune(c))
 		} else if isSpecifier {
 			if err := processSpecifier(); err != nil {
-				return err
+				return pos, err
 			}
 		} else if isText {
 			if err := verifyText(); err != nil {
-				return err
+				return pos, err
 			}
 		}
 	}
 
-	if pos < len(value) {
-		return fmt.Errorf(extraTextErrMsg, value, value[pos:])
+	if pos < len(value) && opts&ParseTrailingText == 0 {
+		return pos, fmt.Errorf(extraTextErrMsg, value, value[pos:])
 	}
 
-	return applyParts(parts, date, time, offset)
+	return pos, applyParts(parts, date, time, offset)
 }
 
 func applyParts(parts parts, date, time, offset *int64) error {
@@ -874,35 +1095,74 @@ func applyParts(parts parts, date, time, offset *int64) error {
 }
 
 func parseSpecifier(buf []rune) (nopad, localed bool, precision uint, main rune, err error) {
-	if len(buf) == 3 {
-		switch {
-		case buf[1] == '-':
-			nopad = true
-		case buf[1] == 'E':
-			localed = true
-		case buf[1] >= '0' && buf[1] <= '9':
-			precision = uint(buf[1] - 48)
-		default:
-			return false, false, 0, 0, fmt.Errorf("unsupported modifier '%c'", buf[1])
-		}
-	} else if len(buf) == 4 {
-		switch buf[1] {
-		case '-':
-			nopad = true
-		default:
-			return false, false, 0, 0, fmt.Errorf("unsupported modifier '%c'", buf[1])
-		}
+	// Modifiers must appear in the order '-', precision, 'E', e.g. %-3EH.
+	modifiers := buf[1 : len(buf)-1]
+	if len(modifiers) != 0 && modifiers[0] == '-' {
+		nopad = true
+		modifiers = modifiers[1:]
+	}
 
-		switch buf[2] {
-		case 'E':
-			localed = true
-		default:
-			return false, false, 0, 0, fmt.Errorf("unsupported modifier '%c'", buf[1])
-		}
+	if len(modifiers) != 0 && modifiers[0] >= '0' && modifiers[0] <= '9' {
+		precision = uint(modifiers[0] - 48)
+		modifiers = modifiers[1:]
+	}
+
+	if len(modifiers) != 0 && modifiers[0] == 'E' {
+		localed = true
+		modifiers = modifiers[1:]
+	}
+
+	if len(modifiers) != 0 {
+		return false, false, 0, 0, fmt.Errorf("unsupported modifier '%c'", modifiers[0])
 	}
 	return nopad, localed, precision, buf[len(buf)-1], nil
 }
 
+// formatDecimalFraction formats the decimal fraction v/unit according to note (11), where 0 <= v < unit.
+func formatDecimalFraction(v, unit int64, precision uint) string {
+	if precision == 0 {
+		f := decimalFraction(v, unit, 9)
+		if f == 0 {
+			return ""
+		}
+		return "." + strings.TrimRight(fmt.Sprintf("%09d", f), "0")
+	}
+	return fmt.Sprintf(".%0*d", precision, decimalFraction(v, unit, precision))
+}
+
+// formatExpandedYear formats the year according to the expanded representation of ISO 8601.
+func formatExpandedYear(year int, extraDigits uint, nopad bool) string {
+	if nopad {
+		return fmt.Sprintf("%+d", year)
+	}
+	return fmt.Sprintf("%+0*d", 5+extraDigits, year)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+func isSeparator(c byte) bool {
+	return c == '-' || c == ':' || c == '/' || c == '.' || c == ','
+}
+
+func isDecimalMark(c byte) bool {
+	return c == '.' || c == ','
+}
+
+// isFractionSpecifier reports whether layout begins with %f, or with %f and a precision.
+func isFractionSpecifier(layout string) bool {
+	if len(layout) < 2 || layout[0] != '%' {
+		return false
+	}
+
+	i := 1
+	for i < len(layout) && layout[i] >= '0' && layout[i] <= '9' {
+		i++
+	}
+	return i < len(layout) && layout[i] == 'f'
+}
+
 func convert12To24HourClock(hour12 int, isAfternoon bool) (hour24 int) {
 	if isAfternoon && hour12 == 12 {
 		return 12
@@ -1035,3 +1295,69 @@ var shortMonthNameLookup = map[string]int{
 	"nov": int(November),
 	"dec": int(December),
 }
+
+// Formatter implements fmt.Formatter for the date and time types of this package,
+// which cannot implement it themselves because their Format methods accept a layout.
+// A Formatter is returned by the Formatter method of each type, and supports the following verbs:
+//
+//   - %v, %s: The ISO 8601 representation, as returned by String.
+//   - %+v:    A verbose representation for debugging, which includes the internal values.
+//   - %#v:    A Go-syntax representation, as returned by GoString.
+//   - %q:     The ISO 8601 representation as a double-quoted Go string literal.
+//
+// As for strings, the width pads the output with spaces, on the left unless the '-' flag is used,
+// and the precision truncates it, both of which are measured in runes.
+//
+// When a value of one of the types is printed with fmt directly, rather than through its Formatter,
+// %v and %s use its String method, %#v uses its GoString method, and %+v is the same as %v.
+type Formatter struct {
+	typ                   string
+	str, verbose, goValue func() string
+}
+
+// Format implements fmt.Formatter.
+func (f Formatter) Format(s fmt.State, verb rune) {
+	var out string
+	switch {
+	case verb == 'v' && s.Flag('#'):
+		out = f.goValue()
+	case verb == 'v' && s.Flag('+'):
+		out = f.verbose()
+	case verb == 'v' || verb == 's':
+		out = f.str()
+	case verb == 'q':
+		out = strconv.Quote(f.str())
+	default:
+		fmt.Fprintf(s, "%%!%c(chrono.%s=%s)", verb, f.typ, f.str())
+		return
+	}
+
+	if p, ok := s.Precision(); ok && p < utf8.RuneCountInString(out) {
+		out = string([]rune(out)[:p])
+	}
+
+	if w, ok := s.Width(); ok && w > utf8.RuneCountInString(out) {
+		pad := strings.Repeat(" ", w-utf8.RuneCountInString(out))
+		if s.Flag('-') {
+			out += pad
+		} else {
+			out = pad + out
+		}
+	}
+	_, _ = s.Write([]byte(out))
+}
+
+func goSyntaxOffset(o int64) string {
+	hours, mins := o/oneHour, (o%oneHour)/oneMinute
+	if hours != 0 && mins < 0 {
+		mins = -mins
+	}
+	return fmt.Sprintf("%d, %d", hours, mins)
+}
+
+func goSyntaxMonth(m int) string {
+	if m < int(January) || m > int(December) {
+		return fmt.Sprintf("chrono.Month(%d)", m)
+	}
+	return "chrono." + longMonthNames[m-1]
+}
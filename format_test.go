@@ -632,6 +632,84 @@ func TestLocalTime_Parse_12HourClock(t *testing.T) {
 	})
 }
 
+func TestLocalTime_Format_decimal_fractions(t *testing.T) {
+	for _, tt := range []struct {
+		layout   string
+		time     chrono.LocalTime
+		expected string
+	}{
+		{chrono.ISO8601TimeDecimalHours, chrono.LocalTimeOf(10, 30, 0, 0), "T10.5"},
+		{chrono.ISO8601TimeDecimalHours, chrono.LocalTimeOf(10, 0, 0, 0), "T10"},
+		{chrono.ISO8601TimeDecimalHours, chrono.LocalTimeOf(10, 20, 0, 0), "T10.333333333"},
+		{chrono.ISO8601TimeDecimalMinsExtended, chrono.LocalTimeOf(10, 30, 15, 0), "T10:30.25"},
+		{chrono.ISO8601TimeDecimalMinsSimple, chrono.LocalTimeOf(10, 30, 15, 0), "T1030.25"},
+		{"T%2EH", chrono.LocalTimeOf(10, 30, 0, 0), "T10.50"},
+		{"T%1EH", chrono.LocalTimeOf(10, 59, 59, 999999999), "T10.9"},
+		{"T%-EH", chrono.LocalTimeOf(9, 45, 0, 0), "T9.75"},
+		{"%H:%M:%S.%1f", chrono.LocalTimeOf(1, 1, 1, 999999999), "01:01:01.9"},
+		{"%H:%M:%S.%8f", chrono.LocalTimeOf(1, 1, 1, 999999999), "01:01:01.99999999"},
+	} {
+		t.Run(tt.expected, func(t *testing.T) {
+			if formatted := tt.time.Format(tt.layout); formatted != tt.expected {
+				t.Errorf("time.Format(%s) = %s, want %q", tt.layout, formatted, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalTime_Parse_decimal_fractions(t *testing.T) {
+	for _, tt := range []struct {
+		layout   string
+		value    string
+		expected chrono.LocalTime
+	}{
+		{chrono.ISO8601TimeDecimalHours, "T10.5", chrono.LocalTimeOf(10, 30, 0, 0)},
+		{chrono.ISO8601TimeDecimalHours, "T10,5", chrono.LocalTimeOf(10, 30, 0, 0)},
+		{chrono.ISO8601TimeDecimalHours, "T10", chrono.LocalTimeOf(10, 0, 0, 0)},
+		{chrono.ISO8601TimeDecimalHours, "T10.000000000000000000000001", chrono.LocalTimeOf(10, 0, 0, 0)},
+		{chrono.ISO8601TimeDecimalMinsExtended, "T10:30.25", chrono.LocalTimeOf(10, 30, 15, 0)},
+		{chrono.ISO8601TimeDecimalMinsExtended, "T10:30,25", chrono.LocalTimeOf(10, 30, 15, 0)},
+		{chrono.ISO8601TimeDecimalMinsSimple, "T1030.5", chrono.LocalTimeOf(10, 30, 30, 0)},
+		{chrono.ISO8601TimeMillisExtended, "T10:30:15.250", chrono.LocalTimeOf(10, 30, 15, 250000000)},
+		{chrono.ISO8601TimeMillisExtended, "T10:30:15,250", chrono.LocalTimeOf(10, 30, 15, 250000000)},
+		{"%H:%M:%S,%3f", "10:30:15.250", chrono.LocalTimeOf(10, 30, 15, 250000000)},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			var time chrono.LocalTime
+			if err := time.Parse(tt.layout, tt.value); err != nil {
+				t.Errorf("time.Parse(%s, %s) = %v, want nil", tt.layout, tt.value, err)
+			} else if time.Compare(tt.expected) != 0 {
+				t.Errorf("expecting %v, but got %v", tt.expected, time)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		layout string
+		value  string
+	}{
+		{chrono.ISO8601TimeDecimalHours, "T10."},
+		{chrono.ISO8601TimeDecimalHours, "T10.5.5"},
+		{"%H:%M:%S-%3f", "10:30:15,250"},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			var time chrono.LocalTime
+			if err := time.Parse(tt.layout, tt.value); err == nil {
+				t.Errorf("expecting error but got nil")
+			}
+		})
+	}
+}
+
+func TestOffsetDateTime_Parse_decimal_fractions(t *testing.T) {
+	var dt chrono.OffsetDateTime
+	if err := dt.Parse(chrono.ISO8601DateExtended+"T%H:%EM%Ez", "2020-03-18T10:30,5+02:00"); err != nil {
+		t.Errorf("failed to parse datetime: %v", err)
+	} else if expected := chrono.OffsetDateTimeOf(2020, chrono.March, 18, 10, 30, 30, 0, 2, 0); dt.Compare(expected) != 0 {
+		t.Errorf("expecting %v, but got %v", expected, dt)
+	}
+}
+
 func TestLocalDate_Format_eras(t *testing.T) {
 	t.Run("CE", func(t *testing.T) {
 		date := chrono.LocalDateOf(2022, chrono.June, 18)
@@ -712,6 +790,66 @@ func TestLocalDate_Parse_eras(t *testing.T) {
 	})
 }
 
+func TestLocalDate_Format_expanded_years(t *testing.T) {
+	for _, tt := range []struct {
+		layout   string
+		date     chrono.LocalDate
+		expected string
+	}{
+		{"%1Y-%m-%d", chrono.LocalDateOf(10000, chrono.January, 1), "+10000-01-01"},
+		{"%1Y-%m-%d", chrono.LocalDateOf(2020, chrono.January, 1), "+02020-01-01"},
+		{"%1Y-%m-%d", chrono.LocalDateOf(-44, chrono.March, 15), "-00044-03-15"},
+		{"%3Y-%m-%d", chrono.LocalDateOf(5874898, chrono.June, 3), "+5874898-06-03"},
+		{"%1Y-%m-%d", chrono.LocalDateOf(5874898, chrono.June, 3), "+5874898-06-03"},
+		{"%-2Y", chrono.LocalDateOf(0, chrono.January, 1), "+0"},
+	} {
+		t.Run(tt.expected, func(t *testing.T) {
+			if formatted := tt.date.Format(tt.layout); formatted != tt.expected {
+				t.Errorf("date.Format(%s) = %s, want %q", tt.layout, formatted, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalDate_Parse_expanded_years(t *testing.T) {
+	for _, tt := range []struct {
+		layout   string
+		value    string
+		expected chrono.LocalDate
+	}{
+		{"%1Y-%m-%d", "+10000-01-01", chrono.LocalDateOf(10000, chrono.January, 1)},
+		{"%1Y-%m-%d", "-00044-03-15", chrono.LocalDateOf(-44, chrono.March, 15)},
+		{"%3Y-%m-%d", "+5874898-06-03", chrono.LocalDateOf(5874898, chrono.June, 3)},
+		{"%1Y%m%d", "+100000101", chrono.LocalDateOf(10000, chrono.January, 1)},
+		{"%1G-W%V-%u", "+10000-W01-1", chrono.LocalDateOf(10000, chrono.January, 3)},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			var date chrono.LocalDate
+			if err := date.Parse(tt.layout, tt.value); err != nil {
+				t.Errorf("date.Parse(%s, %s) = %v, want nil", tt.layout, tt.value, err)
+			} else if date != tt.expected {
+				t.Errorf("expecting %v, but got %v", tt.expected, date)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		layout string
+		value  string
+	}{
+		{"%1Y-%m-%d", "10000-01-01"},
+		{"%1Y-%m-%d", "+100000-01-01"},
+		{"%1EY", "+10000"},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			var date chrono.LocalDate
+			if err := date.Parse(tt.layout, tt.value); err == nil {
+				t.Errorf("expecting error but got nil")
+			}
+		})
+	}
+}
+
 func TestLocalDate_Parse_century(t *testing.T) {
 	var date chrono.LocalDate
 	if err := date.Parse("%C", "19"); err != nil {
@@ -883,3 +1021,133 @@ func TestLocalDate_Parse_invalid_specifier(t *testing.T) {
 		})
 	}
 }
+
+func TestOffsetDateTime_ParseWith_strict(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		layout    string
+		value     string
+		opts      chrono.ParseOption
+		expectErr bool
+	}{
+		{"exact width", "%Y-%m-%d", "2020-03-18", chrono.ParseStrict, false},
+		{"missing leading zero", "%Y-%m-%d", "2020-3-18", chrono.ParseStrict, true},
+		{"missing leading zero allowed", "%Y-%m-%d", "2020-3-18", 0, false},
+		{"no padding", "%Y-%-m-%d", "2020-3-18", chrono.ParseStrict, false},
+		{"short year", "%Y-%m-%d", "20-03-18", chrono.ParseStrict, true},
+		{"fraction", "%H:%M:%S.%3f", "12:30:15.25", chrono.ParseStrict, true},
+		{"exact case", "%d %B %Y", "18 March 2020", chrono.ParseStrict, false},
+		{"lower case", "%d %B %Y", "18 march 2020", chrono.ParseStrict, true},
+		{"lower case allowed", "%d %B %Y", "18 march 2020", 0, false},
+		{"upper case short", "%a %d %b %Y", "WED 18 MAR 2020", chrono.ParseExactCase, true},
+		{"exact time of day", "%I %p", "12 PM", chrono.ParseStrict, false},
+		{"lower time of day", "%I %p", "12 pm", chrono.ParseStrict, true},
+		{"upper time of day", "%I %P", "12 PM", chrono.ParseStrict, true},
+		{"era", "%EY %EC", "2020 ce", chrono.ParseStrict, true},
+		{"Z with %z", "%H:%M%z", "12:30Z", chrono.ParseStrict, true},
+		{"Z with %z allowed", "%H:%M%z", "12:30Z", 0, false},
+		{"Z with %Ez", "%H:%M%Ez", "12:30Z", chrono.ParseStrict, false},
+		{"numeric offset", "%H:%M%z", "12:30+0000", chrono.ParseStrict, false},
+		{"short offset", "%H:%M%z", "12:30+02", chrono.ParseStrict, true},
+		{"short extended offset", "%H:%M%Ez", "12:30+02", chrono.ParseStrict, true},
+		{"trailing text", "%Y-%m-%d", "2020-03-18 foo", chrono.ParseStrict, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var dt chrono.OffsetDateTime
+			if _, err := dt.ParseWith(tt.layout, tt.value, tt.opts); tt.expectErr && err == nil {
+				t.Errorf("expecting error but got nil")
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("dt.ParseWith(%s, %s) = %v, want nil", tt.layout, tt.value, err)
+			}
+		})
+	}
+}
+
+func TestLocalDateTime_ParseWith_lenient(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		layout   string
+		value    string
+		opts     chrono.ParseOption
+		expected chrono.LocalDateTime
+		n        int
+	}{
+		{"whitespace", "%Y-%m-%d %H:%M", "2020-03-18   12:30", chrono.ParseLenient, chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0), 18},
+		{"no whitespace", "%Y-%m-%d %H:%M", "2020-03-1812:30", chrono.ParseFlexibleSpace, chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0), 15},
+		{"tab", "%Y-%m-%d %H:%M", "2020-03-18\t12:30", chrono.ParseFlexibleSpace, chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0), 16},
+		{"no separators", "%Y-%m-%dT%H:%M:%S", "20200318T123015", chrono.ParseLenient, chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 0), 15},
+		{"some separators", "%Y-%m-%dT%H:%M:%S", "2020-0318T12:3015", chrono.ParseOptionalSeparators, chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 0), 17},
+		{"trailing text", "%Y-%m-%d", "2020-03-18 sshd[123]: accepted", chrono.ParseLenient, chrono.LocalDateTimeOf(2020, chrono.March, 18, 0, 0, 0, 0), 10},
+		{"syslog", "%b %d %H:%M:%S", "Mar  8 12:30:15 host sshd[123]: accepted", chrono.ParseLenient, chrono.LocalDateTimeOf(1970, chrono.March, 8, 12, 30, 15, 0), 15},
+		{"no trailing text", "%Y-%m-%d", "2020-03-18", chrono.ParseTrailingText, chrono.LocalDateTimeOf(2020, chrono.March, 18, 0, 0, 0, 0), 10},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var dt chrono.LocalDateTime
+			if n, err := dt.ParseWith(tt.layout, tt.value, tt.opts); err != nil {
+				t.Errorf("dt.ParseWith(%s, %s) = %v, want nil", tt.layout, tt.value, err)
+			} else if n != tt.n {
+				t.Errorf("dt.ParseWith(%s, %s) consumed %d bytes, want %d", tt.layout, tt.value, n, tt.n)
+			} else if dt.Compare(tt.expected) != 0 {
+				t.Errorf("expecting %v, but got %v", tt.expected, dt)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name   string
+		layout string
+		value  string
+		opts   chrono.ParseOption
+	}{
+		{"whitespace", "%Y-%m-%d %H:%M", "2020-03-18   12:30", chrono.ParseOptionalSeparators},
+		{"separators", "%Y-%m-%d", "20200318", chrono.ParseFlexibleSpace},
+		{"other text", "%Y-%m-%d at %H:%M", "2020-03-18 on 12:30", chrono.ParseLenient},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var dt chrono.LocalDateTime
+			if _, err := dt.ParseWith(tt.layout, tt.value, tt.opts); err == nil {
+				t.Errorf("expecting error but got nil")
+			}
+		})
+	}
+}
+
+func TestFormatter(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		format   string
+		value    fmt.Formatter
+		expected string
+	}{
+		{"LocalDate %v", "%v", chrono.LocalDateOf(2026, chrono.October, 17).Formatter(), "2026-10-17"},
+		{"LocalDate %+v", "%+v", chrono.LocalDateOf(2026, chrono.October, 17).Formatter(), "chrono.LocalDate(2026-10-17, jdn=2461331)"},
+		{"LocalDate %#v", "%#v", chrono.LocalDateOf(2026, chrono.October, 17).Formatter(), "chrono.LocalDateOf(2026, chrono.October, 17)"},
+		{"LocalDate %q", "%q", chrono.LocalDateOf(2026, chrono.October, 17).Formatter(), `"2026-10-17"`},
+		{"LocalDate %12v", "%12v", chrono.LocalDateOf(2026, chrono.October, 17).Formatter(), "  2026-10-17"},
+		{"LocalDate %-12s|", "%-12s|", chrono.LocalDateOf(2026, chrono.October, 17).Formatter(), "2026-10-17  |"},
+		{"LocalDate %.4s", "%.4s", chrono.LocalDateOf(2026, chrono.October, 17).Formatter(), "2026"},
+		{"LocalDate %d", "%d", chrono.LocalDateOf(2026, chrono.October, 17).Formatter(), "%!d(chrono.LocalDate=2026-10-17)"},
+		{"LocalTime %v", "%v", chrono.LocalTimeOf(12, 30, 0, 5).Formatter(), "12:30:00.000000005"},
+		{"LocalTime %+v", "%+v", chrono.LocalTimeOf(12, 30, 0, 0).Formatter(), "chrono.LocalTime(12:30:00, time=45000000000000)"},
+		{"LocalTime %#v", "%#v", chrono.LocalTimeOf(12, 30, 0, 5).Formatter(), "chrono.LocalTimeOf(12, 30, 0, 5)"},
+		{"LocalDateTime %+v", "%+v", chrono.LocalDateTimeOf(2026, chrono.October, 17, 12, 30, 0, 0).Formatter(), "chrono.LocalDateTime(2026-10-17 12:30:00, jdn=2461331, time=45000000000000)"},
+		{"LocalDateTime %#v", "%#v", chrono.LocalDateTimeOf(2026, chrono.October, 17, 12, 30, 0, 0).Formatter(), "chrono.LocalDateTimeOf(2026, chrono.October, 17, 12, 30, 0, 0)"},
+		{"OffsetTime %+v", "%+v", chrono.OffsetTimeOf(12, 30, 0, 0, 2, 0).Formatter(), "chrono.OffsetTime(12:30:00+02:00, time=45000000000000, offset=7200000000000)"},
+		{"OffsetTime %#v", "%#v", chrono.OffsetTimeOf(12, 30, 0, 0, -5, 30).Formatter(), "chrono.OffsetTimeOf(12, 30, 0, 0, -5, 30)"},
+		{"OffsetDateTime %v", "%v", chrono.OffsetDateTimeOf(2026, chrono.October, 17, 12, 30, 0, 0, 2, 0).Formatter(), "2026-10-17 12:30:00+02:00"},
+		{"OffsetDateTime %+v", "%+v", chrono.OffsetDateTimeOf(2026, chrono.October, 17, 12, 30, 0, 0, 0, -30).Formatter(), "chrono.OffsetDateTime(2026-10-17 12:30:00-00:30, jdn=2461331, time=45000000000000, offset=-1800000000000)"},
+		{"OffsetDateTime %#v", "%#v", chrono.OffsetDateTimeOf(2026, chrono.October, 17, 12, 30, 0, 0, 0, -30).Formatter(), "chrono.OffsetDateTimeOf(2026, chrono.October, 17, 12, 30, 0, 0, 0, -30)"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := fmt.Sprintf(tt.format, tt.value); out != tt.expected {
+				t.Errorf("got %q, want %q", out, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalDate_GoString(t *testing.T) {
+	if out := fmt.Sprintf("%#v", chrono.LocalDateOf(-44, chrono.March, 15)); out != "chrono.LocalDateOf(-44, chrono.March, 15)" {
+		t.Errorf("got %q", out)
+	}
+}
@@ -122,6 +122,12 @@ func checkMillis(t *testing.T, time time) {
 	}
 }
 
+func checkDecis(t *testing.T, time time) {
+	if nanos := time.Nanosecond(); nanos != 100000000 {
+		t.Errorf("time.Nanosecond() = %d, want %d", nanos, 100000000)
+	}
+}
+
 func checkMicros(t *testing.T, time time) {
 	if nanos := time.Nanosecond(); nanos != formatMicros*1000 {
 		t.Errorf("time.Nanosecond() = %d, want %d", nanos, formatMillis*1000)
@@ -151,6 +157,9 @@ var (
 		{"%-Y", "807", checkYear, "807"},
 		{"%EY", "0807", checkYear, "0807"},
 		{"%-EY", "807", checkYear, "807"},
+		{"%1Y", "+00807", checkYear, "+00807"},
+		{"%-1Y", "+807", checkYear, "+807"},
+		{"%3Y", "+0000807", checkYear, "+0000807"},
 		{"%y", "07", checkYear, "07"},
 		{"%-y", "7", checkYear, "7"},
 		{"%Ey", "07", checkYear, "07"},
@@ -174,6 +183,7 @@ var (
 		{"%a", "fri", checkWeekday, "Fri"},
 		{"%G", "0807", checkISOYear, "0807"},
 		{"%-G", "807", checkISOYear, "807"},
+		{"%2G", "+000807", checkISOYear, "+000807"},
 		{"%V", "06", checkISOWeek, "06"},
 		{"%-V", "6", checkISOWeek, "6"},
 	}
@@ -193,9 +203,14 @@ var (
 		{"%-M", "5", checkMinute},
 		{"%S", "02", checkSecond},
 		{"%-S", "2", checkSecond},
+		{"%EH", "01.083923182", checkHour},
+		{"%3EH", "01.083", checkHour},
+		{"%EM", "05.035390946", checkMinute},
+		{"%3EM", "05.035", checkMinute},
 		{"%3f", "123", checkMillis},
 		{"%6f", "123457", checkMicros},
 		{"%9f", "123456789", checkNanos},
+		{"%1f", "1", checkDecis},
 		{"%f", "123457", checkMicros},
 	}
 
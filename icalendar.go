@@ -0,0 +1,318 @@
+package chrono
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseICalendarDate parses an RFC 5545 DATE value, such as 20261017.
+func ParseICalendarDate(s string) (LocalDate, error) {
+	var date int64
+	if len(s) != len("20060102") {
+		return 0, fmt.Errorf("parsing iCalendar date: invalid value %q", s)
+	} else if err := parseDateAndTime(recurrenceDateLayout, s, &date, nil, nil); err != nil {
+		return 0, fmt.Errorf("parsing iCalendar date: %v", err)
+	}
+	return LocalDate(date), nil
+}
+
+// FormatICalendarDate formats d as an RFC 5545 DATE value, such as 20261017.
+func FormatICalendarDate(d LocalDate) string {
+	return d.Format(recurrenceDateLayout)
+}
+
+// ParseICalendarDateTime parses an RFC 5545 DATE-TIME value, which is in one of the following forms:
+//   - 20261017T090000, a floating time, which is returned as a *LocalDateTime;
+//   - 20261017T090000Z, a UTC time, which is returned as an *OffsetDateTime in UTC;
+//   - TZID=Europe/London:20261017T090000, a time in a time zone, which is returned as an *OffsetDateTime
+//     in the offset that is in effect at that time.
+//
+// The time zone is UTC or a name in the IANA Time Zone Database, and may be quoted.
+// If the local time is skipped or repeated in the time zone, it is resolved in the same way as by [CronExpression.NextIn].
+func ParseICalendarDateTime(s string) (Chronological, error) {
+	offsets, value, err := parseICalendarTZID(s)
+	if err != nil {
+		return nil, fmt.Errorf("parsing iCalendar date-time: %v", err)
+	}
+
+	out, err := parseICalendarDateTime(value, offsets)
+	if err != nil {
+		return nil, fmt.Errorf("parsing iCalendar date-time: %v", err)
+	}
+	return out, nil
+}
+
+// parseICalendarTZID removes the TZID parameter from s if present, and returns the offsets of its time zone,
+// or nil if s does not have a TZID parameter.
+func parseICalendarTZID(s string) (OffsetFunc, string, error) {
+	if !strings.HasPrefix(s, "TZID=") {
+		return nil, s, nil
+	}
+
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 {
+		return nil, "", fmt.Errorf("invalid value %q", s)
+	}
+
+	name := s[len("TZID="):i]
+	if len(name) >= 2 && name[0] == '"' && name[len(name)-1] == '"' {
+		name = name[1 : len(name)-1]
+	}
+
+	offsets, err := ZoneOffsets(name)
+	if err != nil {
+		return nil, "", err
+	}
+	return offsets, s[i+1:], nil
+}
+
+// parseICalendarDateTime parses a DATE-TIME value without a TZID parameter, which is resolved using offsets if not nil.
+func parseICalendarDateTime(s string, offsets OffsetFunc) (Chronological, error) {
+	var date, time int64
+	switch {
+	case len(s) == len("20060102T150405Z") && s[len(s)-1] == 'Z' && offsets == nil:
+		if err := parseDateAndTime(recurrenceUTCDateTimeLayout, s, &date, &time, nil); err != nil {
+			return nil, err
+		} else if time >= oneDay {
+			return nil, fmt.Errorf("invalid hour in %q", s)
+		}
+		return &OffsetDateTime{v: makeDateTime(date, time)}, nil
+	case len(s) == len("20060102T150405"):
+		if err := parseDateAndTime(recurrenceLocalDateTimeLayout, s, &date, &time, nil); err != nil {
+			return nil, err
+		} else if time >= oneDay {
+			return nil, fmt.Errorf("invalid hour in %q", s)
+		}
+
+		if offsets == nil {
+			return &LocalDateTime{v: makeDateTime(date, time)}, nil
+		}
+
+		out := resolveLocal(makeDateTime(date, time), offsets)
+		return &out, nil
+	default:
+		return nil, fmt.Errorf("invalid value %q", s)
+	}
+}
+
+// FormatICalendarLocalDateTime formats d as a floating RFC 5545 DATE-TIME value, such as 20261017T090000.
+// Fractions of a second are discarded.
+func FormatICalendarLocalDateTime(d LocalDateTime) string {
+	return d.Format(recurrenceLocalDateTimeLayout)
+}
+
+// FormatICalendarDateTime formats d as an RFC 5545 DATE-TIME value in UTC, such as 20261017T080000Z.
+// Fractions of a second are discarded.
+func FormatICalendarDateTime(d OffsetDateTime) string {
+	return d.UTC().Format(recurrenceUTCDateTimeLayout)
+}
+
+// FormatICalendarDateTimeIn formats d as an RFC 5545 DATE-TIME value in the time zone with the provided name,
+// which is UTC or a name in the IANA Time Zone Database, such as TZID=Europe/London:20261017T090000.
+// Fractions of a second are discarded.
+func FormatICalendarDateTimeIn(d OffsetDateTime, zone string) (string, error) {
+	offsets, err := ZoneOffsets(zone)
+	if err != nil {
+		return "", fmt.Errorf("formatting iCalendar date-time: %v", err)
+	}
+	return "TZID=" + zone + ":" + d.In(offsets(d)).Format(recurrenceLocalDateTimeLayout), nil
+}
+
+// ParseICalendarDuration parses an RFC 5545 DURATION value, such as PT1H30M, P15DT5H0M20S or -P1W.
+// Unlike ISO 8601 durations, the value may only contain weeks on their own, or days followed by hours, minutes and seconds,
+// where none of the time components in between those present can be omitted, and fractions are not permitted.
+// Weeks and days are nominal, so they are returned in the [Period], and the sign applies to both the [Period] and the [Duration].
+func ParseICalendarDuration(s string) (Period, Duration, error) {
+	p, d, err := parseICalendarDuration(s)
+	if err != nil {
+		return Period{}, Duration{}, fmt.Errorf("parsing iCalendar duration: %v", err)
+	}
+	return p, d, nil
+}
+
+func parseICalendarDuration(s string) (Period, Duration, error) {
+	v, neg := s, false
+	if v != "" && (v[0] == '+' || v[0] == '-') {
+		v, neg = v[1:], v[0] == '-'
+	}
+
+	if !strings.HasPrefix(v, "P") || len(v) == 1 {
+		return Period{}, Duration{}, fmt.Errorf("invalid value %q", s)
+	}
+	v = v[1:]
+
+	// The designators that may follow each designator, where 'P' is the start of the value.
+	next := map[byte]string{'P': "WDT", 'D': "T", 'T': "HMS", 'H': "M", 'M': "S"}
+
+	var p Period
+	var secs int64
+	last := byte('P')
+	for v != "" {
+		if v[0] == 'T' {
+			if !strings.ContainsRune(next[last], 'T') || len(v) == 1 {
+				return Period{}, Duration{}, fmt.Errorf("invalid value %q", s)
+			}
+			v, last = v[1:], 'T'
+			continue
+		}
+
+		i := 0
+		for i < len(v) && v[i] >= '0' && v[i] <= '9' {
+			i++
+		}
+
+		if i == 0 || i > 9 || i == len(v) || !strings.ContainsRune(next[last], rune(v[i])) {
+			return Period{}, Duration{}, fmt.Errorf("invalid value %q", s)
+		}
+
+		n, _ := strconv.Atoi(v[:i])
+		switch v[i] {
+		case 'W':
+			p.Weeks = n
+		case 'D':
+			p.Days = n
+		case 'H':
+			secs += int64(n) * 3600
+		case 'M':
+			secs += int64(n) * 60
+		case 'S':
+			secs += int64(n)
+		}
+		v, last = v[i+1:], v[i]
+	}
+
+	if last == 'T' {
+		return Period{}, Duration{}, fmt.Errorf("invalid value %q", s)
+	}
+
+	if neg {
+		p.Weeks, p.Days, secs = -p.Weeks, -p.Days, -secs
+	}
+	return p, Duration{secs: secs}, nil
+}
+
+// FormatICalendarDuration formats a combined period and duration as an RFC 5545 DURATION value, such as PT1H30M or -P1W.
+// An error is returned if p contains years, months or fractions, if d contains a fraction of a second,
+// or if the components of p and d do not all have the same sign.
+func FormatICalendarDuration(p Period, d Duration) (string, error) {
+	if p.Years != 0 || p.Months != 0 || p.YearsFraction != 0 || p.MonthsFraction != 0 || p.WeeksFraction != 0 || p.DaysFraction != 0 {
+		return "", fmt.Errorf("formatting iCalendar duration: period %v cannot be represented", p)
+	}
+
+	neg, secs, nsec := d.abs()
+	if nsec != 0 {
+		return "", fmt.Errorf("formatting iCalendar duration: duration %v cannot be represented", d)
+	}
+
+	weeks, days := p.Weeks, p.Days
+	pos := weeks > 0 || days > 0 || (secs != 0 && !neg)
+	neg = weeks < 0 || days < 0 || (secs != 0 && neg)
+	if pos && neg {
+		return "", fmt.Errorf("formatting iCalendar duration: period %v and duration %v have mixed signs", p, d)
+	} else if weeks < 0 || days < 0 {
+		weeks, days = -weeks, -days
+	}
+
+	out := "P"
+	if neg {
+		out = "-P"
+	}
+
+	if weeks != 0 && days == 0 && secs == 0 {
+		return out + strconv.Itoa(weeks) + "W", nil
+	}
+
+	if days += weeks * 7; days != 0 {
+		out += strconv.Itoa(days) + "D"
+		if secs == 0 {
+			return out, nil
+		}
+	}
+
+	h, m, sec := secs/3600, secs/60%60, secs%60
+	out += "T"
+	if h != 0 {
+		out += strconv.FormatUint(h, 10) + "H"
+	}
+
+	if m != 0 || (h != 0 && sec != 0) {
+		out += strconv.FormatUint(m, 10) + "M"
+	}
+
+	if sec != 0 || secs == 0 {
+		out += strconv.FormatUint(sec, 10) + "S"
+	}
+	return out, nil
+}
+
+// ParseICalendarPeriod parses an RFC 5545 PERIOD value, which is a start and either an end or a duration,
+// such as 20261017T090000Z/20261017T103000Z, 20261017T090000Z/PT1H30M, or TZID=Europe/London:20261017T090000/PT1H30M,
+// where the start and end are UTC times, or times in the time zone of the TZID parameter, as in [ParseICalendarDateTime].
+func ParseICalendarPeriod(s string) (Interval, error) {
+	offsets, value, err := parseICalendarTZID(s)
+	if err != nil {
+		return Interval{}, fmt.Errorf("parsing iCalendar period: %v", err)
+	}
+
+	i := strings.IndexByte(value, '/')
+	if i < 0 {
+		return Interval{}, fmt.Errorf("parsing iCalendar period: invalid value %q", s)
+	}
+
+	start, err := parseICalendarPeriodDateTime(value[:i], offsets)
+	if err != nil {
+		return Interval{}, fmt.Errorf("parsing iCalendar period: %v", err)
+	}
+
+	if end := value[i+1:]; end != "" && (end[0] == 'P' || end[0] == '+' || end[0] == '-') {
+		p, d, err := parseICalendarDuration(end)
+		if err != nil {
+			return Interval{}, fmt.Errorf("parsing iCalendar period: %v", err)
+		}
+		return IntervalOfStartDuration(start, p, d, 0), nil
+	}
+
+	end, err := parseICalendarPeriodDateTime(value[i+1:], offsets)
+	if err != nil {
+		return Interval{}, fmt.Errorf("parsing iCalendar period: %v", err)
+	}
+	return IntervalOfStartEnd(start, end, 0), nil
+}
+
+func parseICalendarPeriodDateTime(s string, offsets OffsetFunc) (OffsetDateTime, error) {
+	v, err := parseICalendarDateTime(s, offsets)
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+
+	out, ok := v.(*OffsetDateTime)
+	if !ok {
+		return OffsetDateTime{}, fmt.Errorf("floating time %q is not supported", s)
+	}
+	return *out, nil
+}
+
+// FormatICalendarPeriod formats i as an RFC 5545 PERIOD value in UTC. If i has a start and a duration,
+// it is formatted as a start and a duration, as in [FormatICalendarDuration], and otherwise as a start and an end.
+// Repetitions are ignored. If the start and end of i cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func FormatICalendarPeriod(i Interval) (string, error) {
+	start, err := i.Start()
+	if err != nil {
+		return "", err
+	}
+
+	if i.s != nil && i.d != nil {
+		d, err := FormatICalendarDuration(i.d.Period, i.d.Duration)
+		if err != nil {
+			return "", err
+		}
+		return FormatICalendarDateTime(start) + "/" + d, nil
+	}
+
+	end, err := i.End()
+	if err != nil {
+		return "", err
+	}
+	return FormatICalendarDateTime(start) + "/" + FormatICalendarDateTime(end), nil
+}
@@ -0,0 +1,210 @@
+package chrono_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestParseICalendarDate(t *testing.T) {
+	if d, err := chrono.ParseICalendarDate("20261017"); err != nil {
+		t.Errorf("failed to parse date: %v", err)
+	} else if expected := chrono.LocalDateOf(2026, chrono.October, 17); d != expected {
+		t.Errorf("date = %v, want %v", d, expected)
+	} else if str := chrono.FormatICalendarDate(d); str != "20261017" {
+		t.Errorf("chrono.FormatICalendarDate() = %v, want 20261017", str)
+	}
+
+	for _, s := range []string{"2026-10-17", "20261317", "2026101", "20261017T090000"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := chrono.ParseICalendarDate(s); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestParseICalendarDateTime(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		expected string
+		floating bool
+	}{
+		{"20261017T090000", "2026-10-17 09:00:00", true},
+		{"20261017T090000Z", "2026-10-17T09:00:00Z", false},
+		{"TZID=Europe/London:20261017T090000", "2026-10-17T09:00:00+01:00", false},
+		{`TZID="America/New_York":20260115T090000`, "2026-01-15T09:00:00-05:00", false},
+		{"TZID=Europe/London:20260329T013000", "2026-03-29T02:30:00+01:00", false},
+		{"TZID=Europe/London:20261025T013000", "2026-10-25T01:30:00+01:00", false},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := chrono.ParseICalendarDateTime(tt.input)
+			if err != nil {
+				t.Fatalf("failed to parse date-time: %v", err)
+			}
+
+			switch v := v.(type) {
+			case *chrono.LocalDateTime:
+				if !tt.floating {
+					t.Errorf("expecting *chrono.OffsetDateTime, got %T", v)
+				} else if str := v.String(); str != tt.expected {
+					t.Errorf("date-time = %v, want %v", str, tt.expected)
+				} else if str := chrono.FormatICalendarLocalDateTime(*v); str != tt.input {
+					t.Errorf("chrono.FormatICalendarLocalDateTime() = %v, want %v", str, tt.input)
+				}
+			case *chrono.OffsetDateTime:
+				if tt.floating {
+					t.Errorf("expecting *chrono.LocalDateTime, got %T", v)
+				} else if str := v.Format(chrono.ISO8601); str != tt.expected {
+					t.Errorf("date-time = %v, want %v", str, tt.expected)
+				}
+			default:
+				t.Errorf("unexpected type %T", v)
+			}
+		})
+	}
+
+	for _, s := range []string{
+		"TZID=Nowhere/Zone:20261017T090000",
+		"TZID=Europe/London:20261017T090000Z",
+		"TZID=Europe/London",
+		"20261017T0900",
+		"20261017T096000",
+		"20261017T240000",
+		"20261017T240000Z",
+		"2026-10-17T09:00:00Z",
+	} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := chrono.ParseICalendarDateTime(s); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestFormatICalendarDateTime(t *testing.T) {
+	d := chrono.OffsetDateTimeOf(2026, chrono.October, 17, 9, 0, 0, 0, 1, 0)
+	if str := chrono.FormatICalendarDateTime(d); str != "20261017T080000Z" {
+		t.Errorf("chrono.FormatICalendarDateTime() = %v, want 20261017T080000Z", str)
+	}
+
+	if str, err := chrono.FormatICalendarDateTimeIn(d, "America/New_York"); err != nil || str != "TZID=America/New_York:20261017T040000" {
+		t.Errorf("chrono.FormatICalendarDateTimeIn() = %v, %v, want TZID=America/New_York:20261017T040000", str, err)
+	}
+
+	if _, err := chrono.FormatICalendarDateTimeIn(d, "Nowhere/Zone"); err == nil {
+		t.Errorf("expecting error, got nil")
+	}
+}
+
+func TestParseICalendarDuration(t *testing.T) {
+	for _, tt := range []struct {
+		input     string
+		period    chrono.Period
+		duration  chrono.Duration
+		formatted string
+	}{
+		{"PT1H30M", chrono.Period{}, chrono.DurationOf(90 * chrono.Minute), "PT1H30M"},
+		{"-P1W", chrono.Period{Weeks: -1}, chrono.Duration{}, "-P1W"},
+		{"+P15DT5H0M20S", chrono.Period{Days: 15}, chrono.DurationOf(5*chrono.Hour + 20*chrono.Second), "P15DT5H0M20S"},
+		{"-P1DT12H", chrono.Period{Days: -1}, chrono.DurationOf(-12 * chrono.Hour), "-P1DT12H"},
+		{"P2D", chrono.Period{Days: 2}, chrono.Duration{}, "P2D"},
+		{"PT0S", chrono.Period{}, chrono.Duration{}, "PT0S"},
+		{"PT90M", chrono.Period{}, chrono.DurationOf(90 * chrono.Minute), "PT1H30M"},
+		{"PT3600S", chrono.Period{}, chrono.DurationOf(chrono.Hour), "PT1H"},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			p, d, err := chrono.ParseICalendarDuration(tt.input)
+			if err != nil {
+				t.Fatalf("failed to parse duration: %v", err)
+			} else if p != tt.period {
+				t.Errorf("period = %v, want %v", p, tt.period)
+			} else if d.Compare(tt.duration) != 0 {
+				t.Errorf("duration = %v, want %v", d, tt.duration)
+			}
+
+			if str, err := chrono.FormatICalendarDuration(p, d); err != nil || str != tt.formatted {
+				t.Errorf("chrono.FormatICalendarDuration() = %v, %v, want %v", str, err, tt.formatted)
+			}
+		})
+	}
+
+	for _, s := range []string{"P1W2D", "PT1H30S", "P1Y", "PT1.5H", "P", "PT", "P1DT", "1D", "P1H", "P1D1D", "-"} {
+		t.Run(s, func(t *testing.T) {
+			if _, _, err := chrono.ParseICalendarDuration(s); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestFormatICalendarDuration_error(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		period   chrono.Period
+		duration chrono.Duration
+	}{
+		{"months", chrono.Period{Months: 1}, chrono.Duration{}},
+		{"fraction of a day", chrono.Period{Days: 1, DaysFraction: 500000000}, chrono.Duration{}},
+		{"fraction of a second", chrono.Period{}, chrono.DurationOf(1500 * chrono.Millisecond)},
+		{"mixed signs", chrono.Period{Days: 1}, chrono.DurationOf(-chrono.Hour)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := chrono.FormatICalendarDuration(tt.period, tt.duration); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestFormatICalendarDuration_mixedSigns(t *testing.T) {
+	_, err := chrono.FormatICalendarDuration(chrono.Period{Days: 1}, chrono.DurationOf(-chrono.Hour))
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	} else if msg := err.Error(); !strings.Contains(msg, "P1D") || !strings.Contains(msg, "-PT1H") {
+		t.Errorf("err = %v, want period P1D and duration -PT1H", msg)
+	}
+}
+
+func TestParseICalendarPeriod(t *testing.T) {
+	for _, tt := range []struct {
+		input     string
+		start     string
+		end       string
+		formatted string
+	}{
+		{"20261017T090000Z/20261017T103000Z", "2026-10-17T09:00:00Z", "2026-10-17T10:30:00Z", "20261017T090000Z/20261017T103000Z"},
+		{"20261017T090000Z/PT1H30M", "2026-10-17T09:00:00Z", "2026-10-17T10:30:00Z", "20261017T090000Z/PT1H30M"},
+		{"TZID=Europe/London:20261017T090000/PT1H30M", "2026-10-17T09:00:00+01:00", "2026-10-17T10:30:00+01:00", "20261017T080000Z/PT1H30M"},
+		{"TZID=Europe/London:20261024T090000/20261026T090000", "2026-10-24T09:00:00+01:00", "2026-10-26T09:00:00Z", "20261024T080000Z/20261026T090000Z"},
+		{"20261017T090000Z/P1W", "2026-10-17T09:00:00Z", "2026-10-24T09:00:00Z", "20261017T090000Z/P1W"},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			i, err := chrono.ParseICalendarPeriod(tt.input)
+			if err != nil {
+				t.Fatalf("failed to parse period: %v", err)
+			}
+
+			if start, err := i.Start(); err != nil || start.Format(chrono.ISO8601) != tt.start {
+				t.Errorf("i.Start() = %v, %v, want %v", start.Format(chrono.ISO8601), err, tt.start)
+			}
+
+			if end, err := i.End(); err != nil || end.Format(chrono.ISO8601) != tt.end {
+				t.Errorf("i.End() = %v, %v, want %v", end.Format(chrono.ISO8601), err, tt.end)
+			}
+
+			if str, err := chrono.FormatICalendarPeriod(i); err != nil || str != tt.formatted {
+				t.Errorf("chrono.FormatICalendarPeriod() = %v, %v, want %v", str, err, tt.formatted)
+			}
+		})
+	}
+
+	for _, s := range []string{"20261017T090000/PT1H", "20261017T090000Z", "20261017T090000Z/P1Y", "20261017T090000Z/20261017T103000"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := chrono.ParseICalendarPeriod(s); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
@@ -8,10 +8,41 @@ import (
 
 // Interval represents the intervening time between two time points.
 type Interval struct {
-	s *OffsetDateTime
-	e *OffsetDateTime
-	d *periodDuration
-	r int
+	s  *OffsetDateTime
+	e  *OffsetDateTime
+	d  *periodDuration
+	r  int
+	sk IntervalEndpoint
+	ek IntervalEndpoint
+}
+
+// IntervalEndpoint describes how the start or end of an [Interval] is expressed.
+type IntervalEndpoint int
+
+// The ways in which the start or end of an interval can be expressed.
+const (
+	// EndpointAbsent means that the endpoint is not present, although it may be calculated from the other endpoint and the duration.
+	EndpointAbsent IntervalEndpoint = iota
+	// EndpointTime means that the endpoint is present as a time point.
+	EndpointTime
+	// EndpointOpen means that the interval is open-ended, i.e. it extends indefinitely, expressed as '..' according to ISO 8601-2.
+	EndpointOpen
+	// EndpointUnknown means that the endpoint exists but is not known, expressed as an empty string according to EDTF.
+	EndpointUnknown
+)
+
+func (e IntervalEndpoint) String() string {
+	if e < EndpointAbsent || e > EndpointUnknown {
+		return fmt.Sprintf("%%!IntervalEndpoint(%d)", e)
+	}
+	return intervalEndpointNames[e]
+}
+
+var intervalEndpointNames = [4]string{
+	EndpointAbsent:  "absent",
+	EndpointTime:    "time",
+	EndpointOpen:    "open",
+	EndpointUnknown: "unknown",
 }
 
 type periodDuration struct {
@@ -34,15 +65,42 @@ func IntervalOfDurationEnd(period Period, duration Duration, end OffsetDateTime,
 	return Interval{e: &end, d: &periodDuration{Period: period, Duration: duration}, r: repetitions}
 }
 
+// IntervalOfStartOpenEnd creates an [Interval] from the provided start time point, and an end that is either
+// [EndpointOpen] or [EndpointUnknown]. If any other endpoint is provided, this function panics.
+func IntervalOfStartOpenEnd(start OffsetDateTime, end IntervalEndpoint, repetitions int) Interval {
+	if end != EndpointOpen && end != EndpointUnknown {
+		panic("invalid endpoint")
+	}
+	return Interval{s: &start, ek: end, r: repetitions}
+}
+
+// IntervalOfOpenStartEnd creates an [Interval] from the provided end time point, and a start that is either
+// [EndpointOpen] or [EndpointUnknown]. If any other endpoint is provided, this function panics.
+func IntervalOfOpenStartEnd(start IntervalEndpoint, end OffsetDateTime, repetitions int) Interval {
+	if start != EndpointOpen && start != EndpointUnknown {
+		panic("invalid endpoint")
+	}
+	return Interval{e: &end, sk: start, r: repetitions}
+}
+
 // ParseInterval parses an ISO 8601 time interval, or a repeating time interval.
 // Time intervals can be expressed in the following forms:
 //   - <start>/<end>
 //   - <start>/<duration>
 //   - <duration>/<end>
 //   - <duration>
+//   - <start>/.. or ../<end>
+//   - <start>/ or /<end>
 //
-// where <start> and <end> is any string that can be parsed by [Parse],
+// where <start> and <end> are date-times with a UTC offset, formatted as 2006-01-02T15:04:05Z07:00,
+// optionally with a fraction of a second of up to 9 digits, such as 2006-01-02T15:04:05.5Z,
+// or to a reduced precision without the seconds, such as 2006-01-02T15:04Z,
 // and <duration> is any string that can be parsed by [ParseDuration].
+// The end may be reduced by omitting its higher-order date components, or the whole date, in which case they are taken from the start.
+// For example, 2026-02-15T09:00Z/17:00Z is equivalent to 2026-02-15T09:00Z/2026-02-15T17:00Z,
+// and 2026-02-15T09:00:00Z/16T17:00:00Z is equivalent to 2026-02-15T09:00:00Z/2026-02-16T17:00:00Z.
+// According to ISO 8601-2, '..' denotes an open start or end, such that the interval extends indefinitely.
+// According to EDTF, an empty string denotes a start or end that exists but is unknown.
 //
 // Repeating time intervals are expressed as such:
 //   - Rn/<interval>
@@ -55,13 +113,7 @@ func IntervalOfDurationEnd(period Period, duration Duration, end OffsetDateTime,
 //
 // Additionally, '--' can be used as the separator, instead of the default '/' character.
 func ParseInterval(s string) (Interval, error) {
-	start, end, pd, r, err := parseInterval(s)
-	return Interval{
-		s: start,
-		e: end,
-		d: pd,
-		r: r,
-	}, err
+	return parseInterval(s)
 }
 
 // String returns the formatted Interval that can be parsed by i.Parse().
@@ -70,45 +122,125 @@ func (i Interval) String() string {
 }
 
 func (i Interval) string(sep string) string {
+	parts := intervalParts{sk: i.sk, ek: i.ek, pd: i.d, repeat: i.Repetitions()}
+	if i.s != nil {
+		parts.start, parts.sk = formatIntervalDateTime(*i.s), EndpointTime
+	}
+
+	if i.e != nil {
+		parts.end, parts.ek = formatIntervalDateTime(*i.e), EndpointTime
+	}
+	return parts.string(sep)
+}
+
+// formatIntervalDateTime formats d in the same manner as [OffsetDateTime.String], but with the 'T' designator.
+func formatIntervalDateTime(d OffsetDateTime) string {
+	date, time := d.Split()
+	return date.String() + "T" + time.String()
+}
+
+// string formats p using sep as the separator. The duration is formatted with [FormatDuration] if present.
+func (p intervalParts) string(sep string) string {
+	var duration string
+	if p.pd != nil {
+		duration = FormatDuration(p.pd.Period, p.pd.Duration)
+	}
+	return p.stringWith(sep, duration)
+}
+
+// stringWith formats p using sep as the separator, and the provided duration, which is omitted if empty.
+func (p intervalParts) stringWith(sep, duration string) string {
 	var out string
-	r := i.Repetitions()
-	switch r {
+	switch p.repeat {
 	case 0:
 		// Omit R.
 	case -1:
 		out = "R" + sep
 	default:
-		out = "R" + strconv.Itoa(r) + sep
+		out = "R" + strconv.Itoa(p.repeat) + sep
 	}
 
-	switch {
-	case i.s != nil && i.e != nil:
-		return out + i.s.Format(ISO8601) + sep + i.e.Format(ISO8601)
-	case i.s != nil && i.d != nil:
-		return out + i.s.Format(ISO8601) + sep + FormatDuration(i.d.Period, i.d.Duration)
-	case i.d != nil && i.e != nil:
-		return out + FormatDuration(i.d.Period, i.d.Duration) + sep + i.e.Format(ISO8601)
-	case i.d != nil:
-		return out + FormatDuration(i.d.Period, i.d.Duration)
+	var parts []string
+	if p.sk != EndpointAbsent {
+		parts = append(parts, formatEndpoint(p.start, p.sk))
+	}
+
+	if duration != "" {
+		parts = append(parts, duration)
+	}
+
+	if p.ek != EndpointAbsent {
+		parts = append(parts, formatEndpoint(p.end, p.ek))
+	}
+	return out + strings.Join(parts, sep)
+}
+
+func formatEndpoint(v string, e IntervalEndpoint) string {
+	switch e {
+	case EndpointOpen:
+		return ".."
+	case EndpointUnknown:
+		return ""
+	default:
+		return v
+	}
+}
+
+// StartEndpoint returns how the start of i is expressed.
+func (i Interval) StartEndpoint() IntervalEndpoint {
+	if i.s != nil {
+		return EndpointTime
+	}
+	return i.sk
+}
+
+// EndEndpoint returns how the end of i is expressed.
+func (i Interval) EndEndpoint() IntervalEndpoint {
+	if i.e != nil {
+		return EndpointTime
+	}
+	return i.ek
+}
+
+// endpointErr returns the error that describes an open or unknown endpoint, or nil.
+func endpointErr(e IntervalEndpoint) error {
+	switch e {
+	case EndpointOpen:
+		return ErrOpenEndpoint
+	case EndpointUnknown:
+		return ErrUnknownEndpoint
 	default:
-		return out
+		return nil
 	}
 }
 
 // Start returns the start time point if present, or a calculated time point if possible
 // by subtracting i.Duration() from i.End().
 // If neither are possible (i.e. only a duration is present),
-// [ErrUnsupportedRepresentation] is returned instead.
+// [ErrUnsupportedRepresentation] is returned instead. If the start is open or unknown,
+// [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
 func (i Interval) Start() (OffsetDateTime, error) {
 	switch {
 	case i.s != nil:
 		return *i.s, nil
+	case i.sk != EndpointAbsent:
+		return OffsetDateTime{}, endpointErr(i.sk)
 	case i.e != nil:
-		d, err := i.d.mul(-1)
+		d, err := i.d.Duration.mul(-1)
+		if err != nil {
+			return OffsetDateTime{}, err
+		}
+
+		p, err := i.d.Period.mul(-1)
+		if err != nil {
+			return OffsetDateTime{}, err
+		}
+
+		v, err := addPeriodDurationToDateTime(i.e.v, p, d)
 		if err != nil {
 			return OffsetDateTime{}, err
 		}
-		return i.e.AddDate(-int(i.d.Years), -int(i.d.Months), -int(i.d.Days)).Add(d), nil
+		return OffsetDateTime{v: v, o: i.e.o}, nil
 	default:
 		return OffsetDateTime{}, ErrUnsupportedRepresentation
 	}
@@ -117,13 +249,20 @@ func (i Interval) Start() (OffsetDateTime, error) {
 // End returns the end time point if present, or a calculated time point if possible
 // by adding i.Duration() to i.Start().
 // If neither are possible, (i.e. only a duration is present),
-// then [ErrUnsupportedRepresentation] is returned instead.
+// then [ErrUnsupportedRepresentation] is returned instead. If the end is open or unknown,
+// [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
 func (i Interval) End() (OffsetDateTime, error) {
 	switch {
 	case i.e != nil:
 		return *i.e, nil
+	case i.ek != EndpointAbsent:
+		return OffsetDateTime{}, endpointErr(i.ek)
 	case i.s != nil:
-		return i.s.AddDate(int(i.d.Years), int(i.d.Months), int(i.d.Days)).Add(i.d.Duration), nil
+		v, err := addPeriodDurationToDateTime(i.s.v, i.d.Period, i.d.Duration)
+		if err != nil {
+			return OffsetDateTime{}, err
+		}
+		return OffsetDateTime{v: v, o: i.s.o}, nil
 	default:
 		return OffsetDateTime{}, ErrUnsupportedRepresentation
 	}
@@ -132,10 +271,15 @@ func (i Interval) End() (OffsetDateTime, error) {
 // Duration returns the [Period] and [Duration] if present, or a calculated [Duration]
 // if possible by substracting i.Start() from i.End(). Note that the latter case,
 // the [Period] returned will always be the zero value.
+// If the start or end is open or unknown, [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
 func (i Interval) Duration() (Period, Duration, error) {
 	switch {
 	case i.d != nil:
 		return i.d.Period, i.d.Duration, nil
+	case i.sk != EndpointAbsent:
+		return Period{}, Duration{}, endpointErr(i.sk)
+	case i.ek != EndpointAbsent:
+		return Period{}, Duration{}, endpointErr(i.ek)
 	case i.s != nil && i.e != nil:
 		return Period{}, i.e.Sub(*i.s), nil
 	default:
@@ -161,11 +305,50 @@ func cutAB(s, sepA, sepB string) (before, after string, found int) {
 	return s, "", 0
 }
 
-func parseInterval(s string) (start, end *OffsetDateTime, pd *periodDuration, repeat int, err error) {
+// intervalParts holds the parts of an interval expression, before its endpoints are parsed.
+// start and end hold the unparsed time points when sk or ek is EndpointTime.
+type intervalParts struct {
+	start, end string
+	sk, ek     IntervalEndpoint
+	pd         *periodDuration
+	repeat     int
+}
+
+func parseInterval(s string) (Interval, error) {
+	parts, err := parseIntervalParts(s)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	out := Interval{d: parts.pd, r: parts.repeat}
+	if parts.sk == EndpointTime {
+		if out.s, err = parseOffsetDateTime(parts.start); err != nil {
+			return Interval{}, err
+		}
+	} else {
+		out.sk = parts.sk
+	}
+
+	if parts.ek == EndpointTime {
+		if parts.sk == EndpointTime {
+			parts.end = completeReducedEnd(parts.start, parts.end)
+		}
+
+		if out.e, err = parseOffsetDateTime(parts.end); err != nil {
+			return Interval{}, err
+		}
+	} else {
+		out.ek = parts.ek
+	}
+	return out, nil
+}
+
+func parseIntervalParts(s string) (intervalParts, error) {
 	if len(s) == 0 {
-		return nil, nil, nil, 0, fmt.Errorf("empty string")
+		return intervalParts{}, fmt.Errorf("empty string")
 	}
 
+	var out intervalParts
 	var sep int
 
 	if s[0] == 'R' {
@@ -173,55 +356,170 @@ func parseInterval(s string) (start, end *OffsetDateTime, pd *periodDuration, re
 
 		var r string
 		if r, s, sep = cutAB(s, "/", "--"); sep == 0 {
-			return nil, nil, nil, 0, fmt.Errorf("parsing interval: missing separator")
+			return intervalParts{}, fmt.Errorf("parsing interval: missing separator")
 		}
 
 		if len(r) == 0 {
-			repeat = -1
-		} else if repeat, err = strconv.Atoi(r); err != nil {
-			return nil, nil, nil, 0, fmt.Errorf("parsing interval: invalid repeat")
+			out.repeat = -1
+		} else if repeat, err := strconv.Atoi(r); err != nil {
+			return intervalParts{}, fmt.Errorf("parsing interval: invalid repeat")
+		} else {
+			out.repeat = repeat
 		}
 	}
 
 	s1, s2, found := cutAB(s, "/", "--")
 	if found != 0 && sep != 0 && found != sep {
-		return nil, nil, nil, 0, fmt.Errorf("inconsistent separators")
+		return intervalParts{}, fmt.Errorf("inconsistent separators")
 	}
 
-	if s1[0] >= '0' && s1[0] <= '9' { // <start>/<end> or <start>/<duation>
-		if s2 == "" { // <start> is invalid
-			return nil, nil, nil, 0, fmt.Errorf("invalid interval")
+	var err error
+	switch {
+	case s1 == "..": // ../<end>
+		out.sk = EndpointOpen
+	case s1 == "" && found != 0: // /<end>
+		out.sk = EndpointUnknown
+	case s1 == "":
+		return intervalParts{}, fmt.Errorf("invalid interval")
+	case isIntervalTimePoint(s1): // <start>/<end> or <start>/<duation>
+		out.start, out.sk = s1, EndpointTime
+	default: // <duration>/<end> or <duration>
+		if out.pd, err = parsePeriodDuration(s1); err != nil {
+			return intervalParts{}, err
 		}
+	}
 
-		if start, err = parseOffsetDateTime(s1); err != nil {
-			return nil, nil, nil, 0, err
+	switch {
+	case found == 0: // <duration>
+	case s2 == "..": // <start>/..
+		out.ek = EndpointOpen
+	case s2 == "": // <start>/
+		out.ek = EndpointUnknown
+	case isIntervalTimePoint(s2): // <start>/<end> or <duration>/<end>
+		out.end, out.ek = s2, EndpointTime
+	default: // <start>/<duation>
+		if out.pd != nil {
+			return intervalParts{}, fmt.Errorf("invalid interval")
 		}
-	} else { // <duration>/<end> or <duration>
-		p, d, err := ParseDuration(s1)
-		if err != nil {
-			return nil, nil, nil, 0, err
+
+		if out.pd, err = parsePeriodDuration(s2); err != nil {
+			return intervalParts{}, err
 		}
-		pd = &periodDuration{p, d}
 	}
 
-	if s2 != "" && s2[0] >= '0' && s2[0] <= '9' { // <start>/<end> or <duration>/<end>
-		if end, err = parseOffsetDateTime(s2); err != nil {
-			return nil, nil, nil, 0, err
-		}
-	} else if s2 != "" { // <start>/<duation>
-		p, d, err := ParseDuration(s2)
-		if err != nil {
-			return nil, nil, nil, 0, err
+	switch {
+	case out.sk == EndpointTime && found == 0: // <start> alone
+		return intervalParts{}, fmt.Errorf("invalid interval")
+	case out.sk != EndpointAbsent && out.sk != EndpointTime && out.ek != EndpointTime: // ../<duration> or ../..
+		return intervalParts{}, fmt.Errorf("invalid interval")
+	case out.pd != nil && out.ek != EndpointAbsent && out.ek != EndpointTime: // <duration>/..
+		return intervalParts{}, fmt.Errorf("invalid interval")
+	}
+	return out, nil
+}
+
+// isIntervalTimePoint reports whether s, which is not empty, is a time point rather than a duration.
+// A time point begins with a digit, or with a sign followed by a digit in the case of an expanded year.
+func isIntervalTimePoint(s string) bool {
+	if s[0] == '+' || s[0] == '-' {
+		s = s[1:]
+	}
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
+
+// expandedYearLayout returns layout, which begins with %Y, with the number of additional digits of the expanded year
+// at the start of value, if any, such that +10000-01-01 is parsed using %1Y-%m-%d.
+func expandedYearLayout(layout, value string) string {
+	if value == "" || (value[0] != '+' && value[0] != '-') {
+		return layout
+	}
+
+	n := 1
+	for n < len(value) && value[n] >= '0' && value[n] <= '9' {
+		n++
+	}
+
+	if n-1 <= 4 {
+		return layout
+	}
+	return "%" + strconv.Itoa(n-5) + layout[1:]
+}
+
+// intervalDateTimeLayout returns the layout with which to parse value as the start or end of an interval.
+// value is a date and time that may have an expanded year, and a fraction of a second or no seconds at all,
+// followed by a UTC offset if offset is true.
+func intervalDateTimeLayout(value string, offset bool) string {
+	t := value
+	if i := strings.IndexByte(t, 'T'); i >= 0 {
+		t = t[i+1:]
+	}
+
+	if i := strings.IndexAny(t, "Z+-"); i >= 0 && offset {
+		t = t[:i]
+	}
+
+	layout := ISO8601DateExtended + "T%H:%M"
+	if strings.Count(t, ":") >= 2 {
+		layout += ":%S"
+		if i := strings.IndexAny(t, ".,"); i >= 0 && len(t)-i-1 >= 1 && len(t)-i-1 <= 9 {
+			layout += ".%" + strconv.Itoa(len(t)-i-1) + "f"
 		}
-		pd = &periodDuration{p, d}
 	}
 
-	return start, end, pd, repeat, nil
+	if offset {
+		layout += "%Ez"
+	}
+	return expandedYearLayout(layout, value)
+}
+
+// completeReducedEnd returns end with any higher-order date components, or the whole date, that were omitted from it according to ISO 8601,
+// such as in 2026-02-15/28, taken from start. The date components that remain in end must have the same widths and separators as the
+// corresponding components of start, otherwise end is returned unchanged. The time of day is never completed,
+// so it may be expressed to a different precision in start and end, such as in 2026-02-15T09:00:00/17:00.
+func completeReducedEnd(start, end string) string {
+	startDate := start
+	if i := strings.IndexByte(start, 'T'); i >= 0 {
+		startDate = start[:i]
+	}
+
+	endDate, endTime := end, ""
+	if i := strings.IndexByte(end, 'T'); i >= 0 {
+		endDate, endTime = end[:i], end[i:]
+	} else if strings.IndexByte(end, ':') >= 0 {
+		endDate, endTime = "", "T"+end
+	}
+
+	n := len(startDate) - len(endDate)
+	switch {
+	case n <= 0:
+		return end
+	case endDate != "" && (n < 2 || startDate[n-1] != '-' || digitShape(startDate[n:]) != digitShape(endDate)):
+		return end
+	}
+	return startDate[:n] + endDate + endTime
+}
+
+// digitShape returns s with each of its digits replaced by 0.
+func digitShape(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return '0'
+		}
+		return r
+	}, s)
+}
+
+func parsePeriodDuration(s string) (*periodDuration, error) {
+	p, d, err := ParseDuration(s)
+	if err != nil {
+		return nil, err
+	}
+	return &periodDuration{p, d}, nil
 }
 
 func parseOffsetDateTime(value string) (*OffsetDateTime, error) {
 	var date, time, offset int64
-	if err := parseDateAndTime(ISO8601, value, &date, &time, &offset); err != nil {
+	if err := parseDateAndTime(intervalDateTimeLayout(value, true), value, &date, &time, &offset); err != nil {
 		return nil, err
 	}
 
@@ -230,3 +528,459 @@ func parseOffsetDateTime(value string) (*OffsetDateTime, error) {
 		o: offset,
 	}, nil
 }
+
+// Occurrences returns each occurrence of a repeating interval, as intervals that each have a start and an end.
+// See [IntervalCursor] for how the occurrences are calculated.
+// If the interval repeats an unbounded number of times, or if only a duration is present,
+// [ErrUnsupportedRepresentation] is returned instead. Use Cursor to iterate over an unbounded interval.
+func (i Interval) Occurrences() ([]Interval, error) {
+	if i.Repetitions() == -1 {
+		return nil, ErrUnsupportedRepresentation
+	}
+
+	c, err := i.Cursor()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Interval
+	for c.Next() {
+		out = append(out, c.Interval())
+	}
+	return out, c.Err()
+}
+
+// Cursor returns an [IntervalCursor] that iterates over the occurrences of a repeating interval.
+// If only a duration is present, [ErrUnsupportedRepresentation] is returned instead.
+// If the start or end is open or unknown, [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
+func (i Interval) Cursor() (*IntervalCursor, error) {
+	if i.sk != EndpointAbsent {
+		return nil, endpointErr(i.sk)
+	} else if i.ek != EndpointAbsent {
+		return nil, endpointErr(i.ek)
+	}
+
+	c := &IntervalCursor{remaining: i.Repetitions()}
+	if c.remaining == 0 {
+		c.remaining = 1
+	}
+
+	switch {
+	case i.s != nil && i.e != nil:
+		c.anchor, c.end = *i.s, *i.e
+		c.step = i.e.Sub(*i.s)
+	case i.s != nil && i.d != nil:
+		c.anchor = *i.s
+		c.pd = i.d
+	case i.d != nil && i.e != nil:
+		c.anchor = *i.e
+		c.pd = i.d
+		c.backward = true
+	default:
+		return nil, ErrUnsupportedRepresentation
+	}
+	return c, nil
+}
+
+// IntervalCursor iterates over the occurrences of a repeating [Interval], including an interval that repeats an unbounded number of times.
+// An interval without a repeat expression, or with a repeat expression of R0, has a single occurrence, Rn has n occurrences,
+// and R has an unbounded number of occurrences.
+//
+// The occurrences of an interval with a start and an end are each the same length as the interval.
+// Otherwise, the start of the nth occurrence (counting from 0) is calculated by adding the period and duration multiplied by n
+// to the start of the interval, such that calendar arithmetic is applied from the start of the interval rather than accumulated
+// across occurrences. Each occurrence ends where the next one starts. An interval with a duration and an end repeats backwards,
+// such that the first occurrence ends at the end of the interval, and each following occurrence ends where the previous one starts.
+//
+// A cursor is used as follows:
+//
+//	c, err := i.Cursor()
+//	if err != nil {
+//		...
+//	}
+//
+//	for c.Next() {
+//		start, end := c.Start(), c.End()
+//		...
+//	}
+//
+//	if err := c.Err(); err != nil {
+//		...
+//	}
+type IntervalCursor struct {
+	anchor    OffsetDateTime
+	end       OffsetDateTime
+	step      Duration
+	pd        *periodDuration
+	backward  bool
+	remaining int
+	n         int
+
+	start, stop OffsetDateTime
+	err         error
+}
+
+// Next advances the cursor to the next occurrence, which is then available through Start and End.
+// It returns false when there are no more occurrences, or if an occurrence falls outside of the allowed range,
+// in which case Err returns the error.
+func (c *IntervalCursor) Next() bool {
+	if c.err != nil || c.remaining == 0 {
+		return false
+	}
+
+	var err error
+	switch {
+	case c.n == 0 || c.pd == nil:
+		c.start, c.stop, err = c.occurrence(c.n)
+	case c.backward:
+		c.stop = c.start
+		c.start, err = c.boundary(c.n + 1)
+	default:
+		c.start = c.stop
+		c.stop, err = c.boundary(c.n + 1)
+	}
+
+	if err != nil {
+		c.err = err
+		return false
+	}
+
+	c.n++
+	if c.remaining > 0 {
+		c.remaining--
+	}
+	return true
+}
+
+// occurrence returns the start and end of the nth occurrence.
+func (c *IntervalCursor) occurrence(n int) (start, end OffsetDateTime, err error) {
+	if c.pd == nil {
+		d, err := c.step.mul(int64(n))
+		if err != nil {
+			return OffsetDateTime{}, OffsetDateTime{}, err
+		}
+
+		if start.v, err = addDurationToDateTime(c.anchor.v, d); err != nil {
+			return OffsetDateTime{}, OffsetDateTime{}, err
+		}
+
+		if end.v, err = addDurationToDateTime(c.end.v, d); err != nil {
+			return OffsetDateTime{}, OffsetDateTime{}, err
+		}
+
+		start.o, end.o = c.anchor.o, c.end.o
+		return start, end, nil
+	}
+
+	if start, err = c.boundary(n); err != nil {
+		return OffsetDateTime{}, OffsetDateTime{}, err
+	}
+
+	if end, err = c.boundary(n + 1); err != nil {
+		return OffsetDateTime{}, OffsetDateTime{}, err
+	}
+
+	if c.backward {
+		start, end = end, start
+	}
+	return start, end, nil
+}
+
+// boundary returns the anchor plus the period and duration multiplied by n, or minus if the cursor iterates backwards.
+func (c *IntervalCursor) boundary(n int) (OffsetDateTime, error) {
+	if c.backward {
+		n = -n
+	}
+
+	p, err := c.pd.Period.mul(n)
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+
+	d, err := c.pd.Duration.mul(int64(n))
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+
+	v, err := addPeriodDurationToDateTime(c.anchor.v, p, d)
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+	return OffsetDateTime{v: v, o: c.anchor.o}, nil
+}
+
+// Start returns the start of the current occurrence.
+func (c *IntervalCursor) Start() OffsetDateTime {
+	return c.start
+}
+
+// End returns the end of the current occurrence.
+func (c *IntervalCursor) End() OffsetDateTime {
+	return c.stop
+}
+
+// Interval returns the current occurrence as an [Interval] with a start and an end.
+func (c *IntervalCursor) Interval() Interval {
+	return IntervalOfStartEnd(c.start, c.stop, 0)
+}
+
+// Index returns the index of the current occurrence, counting from 0.
+func (c *IntervalCursor) Index() int {
+	return c.n - 1
+}
+
+// Err returns the error, if any, that caused Next to return false before all of the occurrences were returned.
+func (c *IntervalCursor) Err() error {
+	return c.err
+}
+
+// IntervalRelation is one of the 13 relations between two intervals defined by Allen's interval algebra.
+type IntervalRelation int
+
+// The relations between two intervals x and y, where x is the receiver of [Interval.Relation].
+const (
+	RelationBefore       IntervalRelation = iota + 1 // x ends before y starts.
+	RelationMeets                                    // x ends when y starts.
+	RelationOverlaps                                 // x starts before y starts, and ends after y starts but before y ends.
+	RelationFinishedBy                               // x starts before y starts, and ends when y ends.
+	RelationContains                                 // x starts before y starts, and ends after y ends.
+	RelationStarts                                   // x starts when y starts, and ends before y ends.
+	RelationEquals                                   // x starts when y starts, and ends when y ends.
+	RelationStartedBy                                // x starts when y starts, and ends after y ends.
+	RelationDuring                                   // x starts after y starts, and ends before y ends.
+	RelationFinishes                                 // x starts after y starts, and ends when y ends.
+	RelationOverlappedBy                             // x starts after y starts but before y ends, and ends after y ends.
+	RelationMetBy                                    // x starts when y ends.
+	RelationAfter                                    // x starts after y ends.
+)
+
+func (r IntervalRelation) String() string {
+	if r < RelationBefore || r > RelationAfter {
+		return fmt.Sprintf("%%!IntervalRelation(%d)", r)
+	}
+	return intervalRelationNames[r-1]
+}
+
+var intervalRelationNames = [13]string{
+	RelationBefore - 1:       "before",
+	RelationMeets - 1:        "meets",
+	RelationOverlaps - 1:     "overlaps",
+	RelationFinishedBy - 1:   "finished by",
+	RelationContains - 1:     "contains",
+	RelationStarts - 1:       "starts",
+	RelationEquals - 1:       "equals",
+	RelationStartedBy - 1:    "started by",
+	RelationDuring - 1:       "during",
+	RelationFinishes - 1:     "finishes",
+	RelationOverlappedBy - 1: "overlapped by",
+	RelationMetBy - 1:        "met by",
+	RelationAfter - 1:        "after",
+}
+
+// bounds returns the start and end of i, as returned by Start and End.
+func (i Interval) bounds() (start, end OffsetDateTime, err error) {
+	if start, err = i.Start(); err != nil {
+		return OffsetDateTime{}, OffsetDateTime{}, err
+	}
+
+	if end, err = i.End(); err != nil {
+		return OffsetDateTime{}, OffsetDateTime{}, err
+	}
+	return start, end, nil
+}
+
+// compareInstants compares the instants represented by d and d2, taking into account their offsets.
+func compareInstants(d, d2 OffsetDateTime) int {
+	return compareDateTimes(dateTimeToOffset(d.v, d.o, 0), dateTimeToOffset(d2.v, d2.o, 0))
+}
+
+// Contains reports whether t falls within i, where i includes its start but excludes its end.
+// Repetitions are ignored, and the offsets of the time points are taken into account.
+// If the start and end of i cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func (i Interval) Contains(t OffsetDateTime) (bool, error) {
+	start, end, err := i.bounds()
+	if err != nil {
+		return false, err
+	}
+	return compareInstants(start, t) <= 0 && compareInstants(t, end) == -1, nil
+}
+
+// Overlaps reports whether i and j share any instant, where each interval includes its start but excludes its end.
+// Repetitions are ignored, and the offsets of the time points are taken into account.
+// If the start and end of either interval cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func (i Interval) Overlaps(j Interval) (bool, error) {
+	s1, e1, s2, e2, err := resolveIntervals(i, j)
+	if err != nil {
+		return false, err
+	}
+	return compareInstants(s1, e2) == -1 && compareInstants(s2, e1) == -1, nil
+}
+
+// Abuts reports whether i ends when j starts, or j ends when i starts.
+// Repetitions are ignored, and the offsets of the time points are taken into account.
+// If the start and end of either interval cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func (i Interval) Abuts(j Interval) (bool, error) {
+	s1, e1, s2, e2, err := resolveIntervals(i, j)
+	if err != nil {
+		return false, err
+	}
+	return compareInstants(e1, s2) == 0 || compareInstants(e2, s1) == 0, nil
+}
+
+// Intersection returns the interval during which i and j overlap.
+// If they do not overlap, [ErrEmptyInterval] is returned.
+// Repetitions are ignored, and the returned interval has a start and an end, each of which keeps its original offset.
+// If the start and end of either interval cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func (i Interval) Intersection(j Interval) (Interval, error) {
+	s1, e1, s2, e2, err := resolveIntervals(i, j)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	start, end := laterInstant(s1, s2), earlierInstant(e1, e2)
+	if compareInstants(start, end) != -1 {
+		return Interval{}, ErrEmptyInterval
+	}
+	return IntervalOfStartEnd(start, end, 0), nil
+}
+
+// Union returns the interval that covers both i and j, which must overlap or abut.
+// If they neither overlap nor abut, the union cannot be represented as a single interval,
+// and [ErrUnsupportedRepresentation] is returned.
+// Repetitions are ignored, and the returned interval has a start and an end, each of which keeps its original offset.
+// If the start and end of either interval cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func (i Interval) Union(j Interval) (Interval, error) {
+	s1, e1, s2, e2, err := resolveIntervals(i, j)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	if compareInstants(s1, e2) == 1 || compareInstants(s2, e1) == 1 {
+		return Interval{}, ErrUnsupportedRepresentation
+	}
+	return IntervalOfStartEnd(earlierInstant(s1, s2), laterInstant(e1, e2), 0), nil
+}
+
+// Gap returns the interval between i and j.
+// If they overlap or abut, there is no gap, and [ErrEmptyInterval] is returned.
+// Repetitions are ignored, and the returned interval has a start and an end, each of which keeps its original offset.
+// If the start and end of either interval cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func (i Interval) Gap(j Interval) (Interval, error) {
+	s1, e1, s2, e2, err := resolveIntervals(i, j)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	switch {
+	case compareInstants(e1, s2) == -1:
+		return IntervalOfStartEnd(e1, s2, 0), nil
+	case compareInstants(e2, s1) == -1:
+		return IntervalOfStartEnd(e2, s1, 0), nil
+	default:
+		return Interval{}, ErrEmptyInterval
+	}
+}
+
+// Relation returns the relation of i to j according to Allen's interval algebra, e.g. RelationBefore if i ends before j starts.
+// Repetitions are ignored, and the offsets of the time points are taken into account.
+// If the start and end of either interval cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func (i Interval) Relation(j Interval) (IntervalRelation, error) {
+	s1, e1, s2, e2, err := resolveIntervals(i, j)
+	if err != nil {
+		return 0, err
+	}
+
+	starts, ends := compareInstants(s1, s2), compareInstants(e1, e2)
+	switch {
+	case compareInstants(e1, s2) == -1:
+		return RelationBefore, nil
+	case compareInstants(e1, s2) == 0:
+		return RelationMeets, nil
+	case compareInstants(s1, e2) == 1:
+		return RelationAfter, nil
+	case compareInstants(s1, e2) == 0:
+		return RelationMetBy, nil
+	case starts == 0 && ends == 0:
+		return RelationEquals, nil
+	case starts == 0 && ends == -1:
+		return RelationStarts, nil
+	case starts == 0:
+		return RelationStartedBy, nil
+	case ends == 0 && starts == 1:
+		return RelationFinishes, nil
+	case ends == 0:
+		return RelationFinishedBy, nil
+	case starts == -1 && ends == 1:
+		return RelationContains, nil
+	case starts == -1:
+		return RelationOverlaps, nil
+	case ends == -1:
+		return RelationDuring, nil
+	default:
+		return RelationOverlappedBy, nil
+	}
+}
+
+// Split partitions i at the boundaries of unit, as observed at the specified offset, and returns the resulting intervals in order,
+// each of which has a start and an end expressed in that offset. The first and last intervals may be shorter than unit.
+// Boundaries are determined as by [LocalDateTime.Truncate], and the duration of each interval is returned by its Duration method.
+// Repetitions are ignored, and if i is empty, nil is returned. If the start and end of i cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func (i Interval) Split(unit Unit, offset Offset) ([]Interval, error) {
+	return i.SplitIn(unit, fixedOffset(int64(offset)))
+}
+
+// SplitIn partitions i in the same way as Split, except that the boundaries of unit are observed in the local time
+// according to offsets, such as that of a time zone returned by [ZoneOffsets], and each start and end is expressed
+// in the offset that is in effect at that time. Thus, an interval that contains a change of offset is shorter or longer than usual,
+// such as a day of 23 hours. A boundary at a local time that is skipped falls at the end of the gap,
+// and a boundary at a local time that is repeated falls at its first occurrence.
+func (i Interval) SplitIn(unit Unit, offsets OffsetFunc) ([]Interval, error) {
+	start, end, err := i.bounds()
+	if err != nil {
+		return nil, err
+	}
+	start, end = start.In(offsets(start)), end.In(offsets(end))
+
+	var out []Interval
+	for compareInstants(start, end) == -1 {
+		local, next := start.v, start
+		for compareInstants(next, start) != 1 {
+			if local, err = addDurationToDateTime(local, durationOf(1)); err != nil {
+				return nil, err
+			} else if local, err = roundDateTime(local, unit, roundUp); err != nil {
+				return nil, err
+			}
+			next = resolveLocal(local, offsets)
+		}
+
+		if compareInstants(next, end) == 1 {
+			next = end
+		}
+
+		out = append(out, IntervalOfStartEnd(start, next, 0))
+		start = next
+	}
+	return out, nil
+}
+
+func resolveIntervals(i, j Interval) (s1, e1, s2, e2 OffsetDateTime, err error) {
+	if s1, e1, err = i.bounds(); err != nil {
+		return
+	}
+	s2, e2, err = j.bounds()
+	return
+}
+
+func earlierInstant(d, d2 OffsetDateTime) OffsetDateTime {
+	if compareInstants(d2, d) == -1 {
+		return d2
+	}
+	return d
+}
+
+func laterInstant(d, d2 OffsetDateTime) OffsetDateTime {
+	if compareInstants(d2, d) == 1 {
+		return d2
+	}
+	return d
+}
@@ -0,0 +1,153 @@
+package chrono
+
+import "sort"
+
+// IntervalSet is a set of time points, represented by a normalized list of intervals which are sorted,
+// and which neither overlap nor abut one another. Each interval includes its start but excludes its end.
+// The zero value is an empty set.
+type IntervalSet struct {
+	spans []span
+}
+
+// span is an interval whose start and end have been resolved.
+type span struct {
+	start, end OffsetDateTime
+}
+
+// IntervalSetOf returns the [IntervalSet] that covers the provided intervals.
+// Overlapping and abutting intervals are merged, empty intervals are discarded, and repetitions are ignored.
+// If the start and end of an interval cannot be resolved, [ErrUnsupportedRepresentation] is returned.
+func IntervalSetOf(intervals ...Interval) (IntervalSet, error) {
+	spans := make([]span, 0, len(intervals))
+	for _, i := range intervals {
+		start, end, err := i.bounds()
+		if err != nil {
+			return IntervalSet{}, err
+		}
+		spans = append(spans, span{start: start, end: end})
+	}
+	return IntervalSet{spans: normalizeSpans(spans)}, nil
+}
+
+// normalizeSpans sorts the spans by their starts, discards empty spans, and merges spans that overlap or abut.
+func normalizeSpans(spans []span) []span {
+	sort.SliceStable(spans, func(i, j int) bool {
+		return compareInstants(spans[i].start, spans[j].start) == -1
+	})
+
+	var out []span
+	for _, s := range spans {
+		if compareInstants(s.start, s.end) != -1 {
+			continue
+		}
+
+		if n := len(out); n > 0 && compareInstants(s.start, out[n-1].end) <= 0 {
+			if compareInstants(s.end, out[n-1].end) == 1 {
+				out[n-1].end = s.end
+			}
+			continue
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+// Intervals returns the normalized intervals of s, each with a start and an end.
+func (s IntervalSet) Intervals() []Interval {
+	out := make([]Interval, len(s.spans))
+	for i, v := range s.spans {
+		out[i] = IntervalOfStartEnd(v.start, v.end, 0)
+	}
+	return out
+}
+
+// IsEmpty reports whether s contains no time points.
+func (s IntervalSet) IsEmpty() bool {
+	return len(s.spans) == 0
+}
+
+// Contains reports whether s contains t, taking into account the offsets of the time points.
+func (s IntervalSet) Contains(t OffsetDateTime) bool {
+	i := sort.Search(len(s.spans), func(i int) bool {
+		return compareInstants(s.spans[i].end, t) == 1
+	})
+	return i < len(s.spans) && compareInstants(s.spans[i].start, t) <= 0
+}
+
+// Duration returns the total duration covered by s.
+// An error is returned if the total would overflow the maximum duration.
+func (s IntervalSet) Duration() (Duration, error) {
+	var out Duration
+	for _, v := range s.spans {
+		var err error
+		if out, err = out.add(v.end.Sub(v.start)); err != nil {
+			return Duration{}, err
+		}
+	}
+	return out, nil
+}
+
+// Union returns the set of time points that are in either s or s2.
+func (s IntervalSet) Union(s2 IntervalSet) IntervalSet {
+	spans := make([]span, 0, len(s.spans)+len(s2.spans))
+	spans = append(spans, s.spans...)
+	spans = append(spans, s2.spans...)
+	return IntervalSet{spans: normalizeSpans(spans)}
+}
+
+// Intersection returns the set of time points that are in both s and s2.
+func (s IntervalSet) Intersection(s2 IntervalSet) IntervalSet {
+	var out []span
+	for i, j := 0, 0; i < len(s.spans) && j < len(s2.spans); {
+		a, b := s.spans[i], s2.spans[j]
+		if start, end := laterInstant(a.start, b.start), earlierInstant(a.end, b.end); compareInstants(start, end) == -1 {
+			out = append(out, span{start: start, end: end})
+		}
+
+		if compareInstants(a.end, b.end) == -1 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return IntervalSet{spans: out}
+}
+
+// Difference returns the set of time points that are in s but not in s2.
+func (s IntervalSet) Difference(s2 IntervalSet) IntervalSet {
+	var out []span
+	j := 0
+	for _, a := range s.spans {
+		for j < len(s2.spans) && compareInstants(s2.spans[j].end, a.start) <= 0 {
+			j++
+		}
+
+		for k := j; k < len(s2.spans) && compareInstants(s2.spans[k].start, a.end) == -1; k++ {
+			b := s2.spans[k]
+			if compareInstants(a.start, b.start) == -1 {
+				out = append(out, span{start: a.start, end: b.start})
+			}
+
+			if compareInstants(b.end, a.start) == 1 {
+				a.start = b.end
+			}
+		}
+
+		if compareInstants(a.start, a.end) == -1 {
+			out = append(out, a)
+		}
+	}
+	return IntervalSet{spans: out}
+}
+
+// String returns the normalized intervals of s formatted according to ISO 8601, separated by commas and enclosed in braces.
+func (s IntervalSet) String() string {
+	out := "{"
+	for i, v := range s.spans {
+		if i > 0 {
+			out += ", "
+		}
+		out += IntervalOfStartEnd(v.start, v.end, 0).String()
+	}
+	return out + "}"
+}
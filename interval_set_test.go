@@ -0,0 +1,157 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func mustIntervalSet(t *testing.T, intervals ...string) chrono.IntervalSet {
+	t.Helper()
+
+	var in []chrono.Interval
+	for _, s := range intervals {
+		i, err := chrono.ParseInterval(s)
+		if err != nil {
+			t.Fatalf("failed to parse interval: %v", err)
+		}
+		in = append(in, i)
+	}
+
+	out, err := chrono.IntervalSetOf(in...)
+	if err != nil {
+		t.Fatalf("IntervalSetOf() = %v", err)
+	}
+	return out
+}
+
+func TestIntervalSetOf(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		intervals []string
+		expected  string
+		duration  chrono.Duration
+	}{
+		{
+			name:     "empty",
+			expected: "{}",
+		},
+		{
+			name:      "sorted",
+			intervals: []string{"2026-01-01T14:00:00Z/PT1H", "2026-01-01T10:00:00Z/PT1H"},
+			expected:  "{2026-01-01T10:00:00Z/2026-01-01T11:00:00Z, 2026-01-01T14:00:00Z/2026-01-01T15:00:00Z}",
+			duration:  chrono.DurationOf(2 * chrono.Hour),
+		},
+		{
+			name:      "merged",
+			intervals: []string{"2026-01-01T10:00:00Z/PT2H", "2026-01-01T11:00:00Z/PT2H", "2026-01-01T13:00:00Z/PT1H", "2026-01-01T10:30:00Z/PT1M"},
+			expected:  "{2026-01-01T10:00:00Z/2026-01-01T14:00:00Z}",
+			duration:  chrono.DurationOf(4 * chrono.Hour),
+		},
+		{
+			name:      "empty intervals discarded",
+			intervals: []string{"2026-01-01T10:00:00Z/2026-01-01T10:00:00Z", "2026-01-01T12:00:00Z/2026-01-01T11:00:00Z"},
+			expected:  "{}",
+		},
+		{
+			name:      "offsets",
+			intervals: []string{"2026-01-01T12:00:00+02:00/PT2H", "2026-01-01T11:00:00Z/PT1H"},
+			expected:  "{2026-01-01T12:00:00+02:00/2026-01-01T14:00:00+02:00}",
+			duration:  chrono.DurationOf(2 * chrono.Hour),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := mustIntervalSet(t, tt.intervals...)
+			if out := s.String(); out != tt.expected {
+				t.Errorf("s.String() = %s, want %s", out, tt.expected)
+			}
+
+			if d, err := s.Duration(); err != nil || d.Compare(tt.duration) != 0 {
+				t.Errorf("s.Duration() = %v, %v, want %v, nil", d, err, tt.duration)
+			}
+
+			if s.IsEmpty() != (len(s.Intervals()) == 0) {
+				t.Errorf("s.IsEmpty() = %t, want %t", s.IsEmpty(), len(s.Intervals()) == 0)
+			}
+		})
+	}
+
+	t.Run("duration only", func(t *testing.T) {
+		i, _ := chrono.ParseInterval("PT1H")
+		if _, err := chrono.IntervalSetOf(i); err != chrono.ErrUnsupportedRepresentation {
+			t.Errorf("IntervalSetOf() = %v, want %v", err, chrono.ErrUnsupportedRepresentation)
+		}
+	})
+}
+
+func TestIntervalSet_Contains(t *testing.T) {
+	s := mustIntervalSet(t, "2026-01-01T10:00:00Z/PT1H", "2026-01-01T14:00:00Z/PT1H")
+	for _, tt := range []struct {
+		name     string
+		t        chrono.OffsetDateTime
+		expected bool
+	}{
+		{"before", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 9, 0, 0, 0, 0, 0), false},
+		{"start", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 10, 0, 0, 0, 0, 0), true},
+		{"end", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 11, 0, 0, 0, 0, 0), false},
+		{"between", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 12, 0, 0, 0, 0, 0), false},
+		{"second interval", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 14, 30, 0, 0, 0, 0), true},
+		{"at offset", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 16, 30, 0, 0, 2, 0), true},
+		{"after", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 15, 0, 0, 0, 0, 0), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := s.Contains(tt.t); ok != tt.expected {
+				t.Errorf("s.Contains(%v) = %t, want %t", tt.t, ok, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIntervalSet_operations(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		s1, s2       []string
+		union        string
+		intersection string
+		difference   string
+	}{
+		{
+			name:         "empty",
+			s1:           []string{"2026-01-01T10:00:00Z/PT1H"},
+			union:        "{2026-01-01T10:00:00Z/2026-01-01T11:00:00Z}",
+			intersection: "{}",
+			difference:   "{2026-01-01T10:00:00Z/2026-01-01T11:00:00Z}",
+		},
+		{
+			name:         "opening hours minus bookings",
+			s1:           []string{"2026-01-01T09:00:00Z/2026-01-01T17:00:00Z", "2026-01-02T09:00:00Z/2026-01-02T17:00:00Z"},
+			s2:           []string{"2026-01-01T08:00:00Z/PT2H", "2026-01-01T12:00:00Z/PT1H", "2026-01-01T16:00:00Z/2026-01-02T10:00:00Z", "2026-01-02T17:00:00Z/PT1H"},
+			union:        "{2026-01-01T08:00:00Z/2026-01-02T18:00:00Z}",
+			intersection: "{2026-01-01T09:00:00Z/2026-01-01T10:00:00Z, 2026-01-01T12:00:00Z/2026-01-01T13:00:00Z, 2026-01-01T16:00:00Z/2026-01-01T17:00:00Z, 2026-01-02T09:00:00Z/2026-01-02T10:00:00Z}",
+			difference:   "{2026-01-01T10:00:00Z/2026-01-01T12:00:00Z, 2026-01-01T13:00:00Z/2026-01-01T16:00:00Z, 2026-01-02T10:00:00Z/2026-01-02T17:00:00Z}",
+		},
+		{
+			name:         "covered",
+			s1:           []string{"2026-01-01T10:00:00Z/PT1H", "2026-01-01T12:00:00Z/PT1H"},
+			s2:           []string{"2026-01-01T09:00:00Z/PT5H"},
+			union:        "{2026-01-01T09:00:00Z/2026-01-01T14:00:00Z}",
+			intersection: "{2026-01-01T10:00:00Z/2026-01-01T11:00:00Z, 2026-01-01T12:00:00Z/2026-01-01T13:00:00Z}",
+			difference:   "{}",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s1, s2 := mustIntervalSet(t, tt.s1...), mustIntervalSet(t, tt.s2...)
+			if out := s1.Union(s2).String(); out != tt.union {
+				t.Errorf("s1.Union(s2) = %s, want %s", out, tt.union)
+			}
+
+			if out := s1.Intersection(s2).String(); out != tt.intersection {
+				t.Errorf("s1.Intersection(s2) = %s, want %s", out, tt.intersection)
+			}
+
+			if out := s1.Difference(s2).String(); out != tt.difference {
+				t.Errorf("s1.Difference(s2) = %s, want %s", out, tt.difference)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package chrono_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -39,6 +40,15 @@ func TestParseInterval(t *testing.T) {
 				duration:   chrono.DurationOf(10490*chrono.Hour + 30*chrono.Minute),
 				durationOk: true,
 			},
+			{
+				str:        "2026-01-01T12:00:00+02:00/2026-01-01T13:00:00Z",
+				start:      chrono.OffsetDateTimeOf(2026, chrono.January, 1, 12, 0, 0, 0, 2, 0),
+				startOk:    true,
+				end:        chrono.OffsetDateTimeOf(2026, chrono.January, 1, 13, 0, 0, 0, 0, 0),
+				endOk:      true,
+				duration:   chrono.DurationOf(3 * chrono.Hour),
+				durationOk: true,
+			},
 			{
 				str:        "2007-03-01T13:00:00Z/P1Y2M10DT2H30M",
 				start:      chrono.OffsetDateTimeOf(2007, chrono.March, 1, 13, 0, 0, 0, 0, 0),
@@ -104,3 +114,733 @@ func TestParseInterval(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInterval_endpoints(t *testing.T) {
+	start := chrono.OffsetDateTimeOf(2026, chrono.January, 1, 0, 0, 0, 0, 0, 0)
+	end := chrono.OffsetDateTimeOf(2026, chrono.December, 31, 0, 0, 0, 0, 0, 0)
+
+	for _, tt := range []struct {
+		str       string
+		startKind chrono.IntervalEndpoint
+		endKind   chrono.IntervalEndpoint
+		startErr  error
+		endErr    error
+		reps      int
+	}{
+		{str: "2026-01-01T00:00:00Z/..", startKind: chrono.EndpointTime, endKind: chrono.EndpointOpen, endErr: chrono.ErrOpenEndpoint},
+		{str: "../2026-12-31T00:00:00Z", startKind: chrono.EndpointOpen, endKind: chrono.EndpointTime, startErr: chrono.ErrOpenEndpoint},
+		{str: "2026-01-01T00:00:00Z/", startKind: chrono.EndpointTime, endKind: chrono.EndpointUnknown, endErr: chrono.ErrUnknownEndpoint},
+		{str: "/2026-12-31T00:00:00Z", startKind: chrono.EndpointUnknown, endKind: chrono.EndpointTime, startErr: chrono.ErrUnknownEndpoint},
+		{str: "R2/2026-01-01T00:00:00Z/..", startKind: chrono.EndpointTime, endKind: chrono.EndpointOpen, endErr: chrono.ErrOpenEndpoint, reps: 2},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			i, err := chrono.ParseInterval(tt.str)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			}
+
+			if k := i.StartEndpoint(); k != tt.startKind {
+				t.Errorf("i.StartEndpoint() = %v, want %v", k, tt.startKind)
+			}
+
+			if k := i.EndEndpoint(); k != tt.endKind {
+				t.Errorf("i.EndEndpoint() = %v, want %v", k, tt.endKind)
+			}
+
+			if dt, err := i.Start(); tt.startErr != nil {
+				if !errors.Is(err, tt.startErr) || !errors.Is(err, chrono.ErrUnsupportedRepresentation) {
+					t.Errorf("i.Start() = %v, %v, want %v", dt, err, tt.startErr)
+				}
+			} else if err != nil || dt.Compare(start) != 0 {
+				t.Errorf("i.Start() = %v, %v, want %v, nil", dt, err, start)
+			}
+
+			if dt, err := i.End(); tt.endErr != nil {
+				if !errors.Is(err, tt.endErr) || !errors.Is(err, chrono.ErrUnsupportedRepresentation) {
+					t.Errorf("i.End() = %v, %v, want %v", dt, err, tt.endErr)
+				}
+			} else if err != nil || dt.Compare(end) != 0 {
+				t.Errorf("i.End() = %v, %v, want %v, nil", dt, err, end)
+			}
+
+			if _, _, err := i.Duration(); err == nil {
+				t.Error("i.Duration() succeeded, want error")
+			}
+
+			if r := i.Repetitions(); r != tt.reps {
+				t.Errorf("i.Repetitions() = %v, want %v", r, tt.reps)
+			}
+
+			if formatted := i.String(); formatted != tt.str {
+				t.Errorf("i.String() = %v, want %v", formatted, tt.str)
+			}
+		})
+	}
+
+	for _, str := range []string{
+		"../..",
+		"/",
+		"../",
+		"P1D/..",
+		"../P1D",
+		"/P1D",
+		"2026-01-01T00:00:00Z",
+	} {
+		t.Run(str, func(t *testing.T) {
+			if _, err := chrono.ParseInterval(str); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestParseInterval_reducedPrecision(t *testing.T) {
+	for _, tt := range []struct {
+		str       string
+		formatted string
+		start     chrono.OffsetDateTime
+		end       chrono.OffsetDateTime
+	}{
+		{
+			str:       "2026-01-15T10:00Z/2026-03-02T08:00Z",
+			formatted: "2026-01-15T10:00:00Z/2026-03-02T08:00:00Z",
+			start:     chrono.OffsetDateTimeOf(2026, chrono.January, 15, 10, 0, 0, 0, 0, 0),
+			end:       chrono.OffsetDateTimeOf(2026, chrono.March, 2, 8, 0, 0, 0, 0, 0),
+		},
+		{
+			str:       "2026-02-15T09:00+01:00/17:30+01:00",
+			formatted: "2026-02-15T09:00:00+01:00/2026-02-15T17:30:00+01:00",
+			start:     chrono.OffsetDateTimeOf(2026, chrono.February, 15, 9, 0, 0, 0, 1, 0),
+			end:       chrono.OffsetDateTimeOf(2026, chrono.February, 15, 17, 30, 0, 0, 1, 0),
+		},
+		{
+			str:       "2026-02-15T09:00:00.5Z/16T17:00:00,25-05:00",
+			formatted: "2026-02-15T09:00:00.500000000Z/2026-02-16T17:00:00.250000000-05:00",
+			start:     chrono.OffsetDateTimeOf(2026, chrono.February, 15, 9, 0, 0, 500000000, 0, 0),
+			end:       chrono.OffsetDateTimeOf(2026, chrono.February, 16, 17, 0, 0, 250000000, -5, 0),
+		},
+		{
+			str:       "+10000-01-01T00:00Z/02T00:00Z",
+			formatted: "+10000-01-01T00:00:00Z/+10000-01-02T00:00:00Z",
+			start:     chrono.OffsetDateTimeOf(10000, chrono.January, 1, 0, 0, 0, 0, 0, 0),
+			end:       chrono.OffsetDateTimeOf(10000, chrono.January, 2, 0, 0, 0, 0, 0, 0),
+		},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			i, err := chrono.ParseInterval(tt.str)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			}
+
+			if dt, err := i.Start(); err != nil || dt != tt.start {
+				t.Errorf("i.Start() = %v, %v, want %v, nil", dt, err, tt.start)
+			}
+
+			if dt, err := i.End(); err != nil || dt != tt.end {
+				t.Errorf("i.End() = %v, %v, want %v, nil", dt, err, tt.end)
+			}
+
+			if formatted := i.String(); formatted != tt.formatted {
+				t.Errorf("i.String() = %v, want %v", formatted, tt.formatted)
+			} else if j, err := chrono.ParseInterval(formatted); err != nil || j.String() != formatted {
+				t.Errorf("chrono.ParseInterval(%q) = %v, %v", formatted, j, err)
+			}
+		})
+	}
+
+	for _, str := range []string{
+		"2026-01-15T10:00:00.1234567890Z/P1D",
+		"2026-01-15T10:00:00.Z/P1D",
+		"2026-01-15T10:00/2026-01-15T12:00",
+		"2026-01-15T10Z/P1D",
+	} {
+		t.Run(str, func(t *testing.T) {
+			if _, err := chrono.ParseInterval(str); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestInterval_String_expandedYears(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		interval chrono.Interval
+		expected string
+	}{
+		{
+			name: "before 0000",
+			interval: chrono.IntervalOfStartEnd(
+				chrono.OffsetDateTimeOf(-44, chrono.March, 15, 0, 0, 0, 0, 0, 0),
+				chrono.OffsetDateTimeOf(-44, chrono.March, 16, 0, 0, 0, 0, 0, 0), 0),
+			expected: "-0044-03-15T00:00:00Z/-0044-03-16T00:00:00Z",
+		},
+		{
+			name:     "after 9999",
+			interval: chrono.IntervalOfStartDuration(chrono.OffsetDateTimeOf(10000, chrono.January, 1, 0, 0, 0, 0, 1, 0), chrono.Period{Days: 1}, chrono.Duration{}, 0),
+			expected: "+10000-01-01T00:00:00+01:00/P1DT0S",
+		},
+		{
+			name:     "duration and end",
+			interval: chrono.IntervalOfDurationEnd(chrono.Period{Days: 1}, chrono.Duration{}, chrono.OffsetDateTimeOf(123456, chrono.June, 1, 12, 0, 0, 0, 0, 0), 2),
+			expected: "R2/P1DT0S/+123456-06-01T12:00:00Z",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			str := tt.interval.String()
+			if str != tt.expected {
+				t.Errorf("i.String() = %v, want %v", str, tt.expected)
+			}
+
+			i, err := chrono.ParseInterval(str)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			} else if out := i.String(); out != str {
+				t.Errorf("i.String() = %v, want %v", out, str)
+			}
+
+			start, err := tt.interval.Start()
+			if err != nil {
+				t.Fatalf("failed to get start: %v", err)
+			} else if dt, err := i.Start(); err != nil || dt.Compare(start) != 0 {
+				t.Errorf("i.Start() = %v, %v, want %v", dt, err, start)
+			}
+		})
+	}
+}
+
+func TestIntervalOfStartOpenEnd(t *testing.T) {
+	start := chrono.OffsetDateTimeOf(2026, chrono.January, 1, 0, 0, 0, 0, 0, 0)
+	if i := chrono.IntervalOfStartOpenEnd(start, chrono.EndpointOpen, 0); i.String() != "2026-01-01T00:00:00Z/.." {
+		t.Errorf("i.String() = %v, want 2026-01-01T00:00:00Z/..", i.String())
+	}
+
+	end := chrono.OffsetDateTimeOf(2026, chrono.December, 31, 0, 0, 0, 0, 0, 0)
+	if i := chrono.IntervalOfOpenStartEnd(chrono.EndpointUnknown, end, 0); i.String() != "/2026-12-31T00:00:00Z" {
+		t.Errorf("i.String() = %v, want /2026-12-31T00:00:00Z", i.String())
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting panic")
+			}
+		}()
+		chrono.IntervalOfStartOpenEnd(start, chrono.EndpointTime, 0)
+	}()
+}
+
+func TestInterval_fractionalPeriod(t *testing.T) {
+	for _, tt := range []struct {
+		str   string
+		start chrono.OffsetDateTime
+		end   chrono.OffsetDateTime
+	}{
+		{
+			str:   "2020-01-01T00:00:00Z/P0.5Y",
+			start: chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 0, 0),
+			end:   chrono.OffsetDateTimeOf(2020, chrono.July, 1, 0, 0, 0, 0, 0, 0),
+		},
+		{
+			str:   "2020-01-01T00:00:00Z/P0.1Y",
+			start: chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 0, 0),
+			end:   chrono.OffsetDateTimeOf(2020, chrono.February, 6, 19, 12, 0, 0, 0, 0),
+		},
+		{
+			str:   "2020-01-01T00:00:00Z/P0.5M",
+			start: chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 0, 0),
+			end:   chrono.OffsetDateTimeOf(2020, chrono.January, 16, 12, 0, 0, 0, 0, 0),
+		},
+		{
+			str:   "2020-01-01T00:00:00+02:00/P1.5WT1H",
+			start: chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			end:   chrono.OffsetDateTimeOf(2020, chrono.January, 11, 13, 0, 0, 0, 2, 0),
+		},
+		{
+			str:   "P0.5M/2020-03-01T00:00:00Z",
+			start: chrono.OffsetDateTimeOf(2020, chrono.February, 14, 12, 0, 0, 0, 0, 0),
+			end:   chrono.OffsetDateTimeOf(2020, chrono.March, 1, 0, 0, 0, 0, 0, 0),
+		},
+		{
+			str:   "P1.5DT1H/2020-03-01T00:00:00Z",
+			start: chrono.OffsetDateTimeOf(2020, chrono.February, 28, 11, 0, 0, 0, 0, 0),
+			end:   chrono.OffsetDateTimeOf(2020, chrono.March, 1, 0, 0, 0, 0, 0, 0),
+		},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			i, err := chrono.ParseInterval(tt.str)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			}
+
+			if start, err := i.Start(); err != nil || start.Compare(tt.start) != 0 {
+				t.Errorf("i.Start() = %v, %v, want %v, nil", start, err, tt.start)
+			}
+
+			if end, err := i.End(); err != nil || end.Compare(tt.end) != 0 {
+				t.Errorf("i.End() = %v, %v, want %v, nil", end, err, tt.end)
+			}
+		})
+	}
+}
+
+func TestInterval_Cursor(t *testing.T) {
+	utc := func(year int, month chrono.Month, day, hour int) chrono.OffsetDateTime {
+		return chrono.OffsetDateTimeOf(year, month, day, hour, 0, 0, 0, 0, 0)
+	}
+
+	for _, tt := range []struct {
+		str      string
+		expected [][2]chrono.OffsetDateTime
+	}{
+		{
+			str: "2026-01-01T00:00:00Z/P1D",
+			expected: [][2]chrono.OffsetDateTime{
+				{utc(2026, chrono.January, 1, 0), utc(2026, chrono.January, 2, 0)},
+			},
+		},
+		{
+			str: "R0/2026-01-01T00:00:00Z/P1D",
+			expected: [][2]chrono.OffsetDateTime{
+				{utc(2026, chrono.January, 1, 0), utc(2026, chrono.January, 2, 0)},
+			},
+		},
+		{
+			str: "R3/2026-01-01T00:00:00Z/P1W",
+			expected: [][2]chrono.OffsetDateTime{
+				{utc(2026, chrono.January, 1, 0), utc(2026, chrono.January, 8, 0)},
+				{utc(2026, chrono.January, 8, 0), utc(2026, chrono.January, 15, 0)},
+				{utc(2026, chrono.January, 15, 0), utc(2026, chrono.January, 22, 0)},
+			},
+		},
+		{
+			str: "R3/2026-01-31T00:00:00Z/P1M",
+			expected: [][2]chrono.OffsetDateTime{
+				{utc(2026, chrono.January, 31, 0), utc(2026, chrono.March, 3, 0)},
+				{utc(2026, chrono.March, 3, 0), utc(2026, chrono.March, 31, 0)},
+				{utc(2026, chrono.March, 31, 0), utc(2026, chrono.May, 1, 0)},
+			},
+		},
+		{
+			str: "R2/2026-01-01T00:00:00Z/P0.5DT1H",
+			expected: [][2]chrono.OffsetDateTime{
+				{utc(2026, chrono.January, 1, 0), utc(2026, chrono.January, 1, 13)},
+				{utc(2026, chrono.January, 1, 13), utc(2026, chrono.January, 2, 2)},
+			},
+		},
+		{
+			str: "R2/2026-01-01T00:00:00Z/2026-01-01T06:00:00+02:00",
+			expected: [][2]chrono.OffsetDateTime{
+				{utc(2026, chrono.January, 1, 0), chrono.OffsetDateTimeOf(2026, chrono.January, 1, 6, 0, 0, 0, 2, 0)},
+				{utc(2026, chrono.January, 1, 4), chrono.OffsetDateTimeOf(2026, chrono.January, 1, 10, 0, 0, 0, 2, 0)},
+			},
+		},
+		{
+			str: "R3/P1M/2026-03-31T00:00:00Z",
+			expected: [][2]chrono.OffsetDateTime{
+				{utc(2026, chrono.March, 3, 0), utc(2026, chrono.March, 31, 0)},
+				{utc(2026, chrono.January, 31, 0), utc(2026, chrono.March, 3, 0)},
+				{utc(2025, chrono.December, 31, 0), utc(2026, chrono.January, 31, 0)},
+			},
+		},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			i, err := chrono.ParseInterval(tt.str)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			}
+
+			c, err := i.Cursor()
+			if err != nil {
+				t.Fatalf("i.Cursor() = %v", err)
+			}
+
+			var n int
+			for ; c.Next(); n++ {
+				if n >= len(tt.expected) {
+					t.Fatalf("unexpected occurrence %d: %v/%v", n, c.Start(), c.End())
+				}
+
+				if c.Index() != n {
+					t.Errorf("c.Index() = %d, want %d", c.Index(), n)
+				}
+
+				if c.Start() != tt.expected[n][0] || c.End() != tt.expected[n][1] {
+					t.Errorf("occurrence %d = %v/%v, want %v/%v", n, c.Start(), c.End(), tt.expected[n][0], tt.expected[n][1])
+				}
+			}
+
+			if err := c.Err(); err != nil {
+				t.Errorf("c.Err() = %v", err)
+			} else if n != len(tt.expected) {
+				t.Errorf("got %d occurrences, want %d", n, len(tt.expected))
+			}
+
+			if out, err := i.Occurrences(); err != nil {
+				t.Errorf("i.Occurrences() = %v", err)
+			} else if len(out) != len(tt.expected) {
+				t.Errorf("len(i.Occurrences()) = %d, want %d", len(out), len(tt.expected))
+			}
+		})
+	}
+
+	t.Run("unbounded", func(t *testing.T) {
+		i, _ := chrono.ParseInterval("R/2026-01-01T00:00:00Z/PT1H")
+		if _, err := i.Occurrences(); err != chrono.ErrUnsupportedRepresentation {
+			t.Errorf("i.Occurrences() = %v, want %v", err, chrono.ErrUnsupportedRepresentation)
+		}
+
+		c, err := i.Cursor()
+		if err != nil {
+			t.Fatalf("i.Cursor() = %v", err)
+		}
+
+		for n := 0; n < 1000; n++ {
+			if !c.Next() {
+				t.Fatalf("c.Next() = false, want true")
+			}
+		}
+
+		if expected := utc(2026, chrono.February, 11, 15); c.Start() != expected {
+			t.Errorf("c.Start() = %v, want %v", c.Start(), expected)
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		i := chrono.IntervalOfStartDuration(chrono.OffsetDateTimeOf(5874898, chrono.May, 20, 0, 0, 0, 0, 0, 0), chrono.Period{Days: 1}, chrono.Duration{}, -1)
+		c, err := i.Cursor()
+		if err != nil {
+			t.Fatalf("i.Cursor() = %v", err)
+		}
+
+		for n := 0; c.Next(); n++ {
+			if n > 100 {
+				t.Fatal("expecting c.Next() to return false")
+			}
+		}
+
+		if c.Err() == nil {
+			t.Error("expecting error but got nil")
+		}
+	})
+
+	t.Run("duration only", func(t *testing.T) {
+		i, _ := chrono.ParseInterval("R5/P1D")
+		if _, err := i.Cursor(); err != chrono.ErrUnsupportedRepresentation {
+			t.Errorf("i.Cursor() = %v, want %v", err, chrono.ErrUnsupportedRepresentation)
+		}
+	})
+}
+
+func TestInterval_Relation(t *testing.T) {
+	at := func(hour int) chrono.OffsetDateTime {
+		return chrono.OffsetDateTimeOf(2026, chrono.January, 1, hour, 0, 0, 0, 0, 0)
+	}
+
+	y := chrono.IntervalOfStartEnd(at(10), at(14), 0)
+	for _, tt := range []struct {
+		x        chrono.Interval
+		expected chrono.IntervalRelation
+		overlaps bool
+		abuts    bool
+	}{
+		{chrono.IntervalOfStartEnd(at(6), at(8), 0), chrono.RelationBefore, false, false},
+		{chrono.IntervalOfStartEnd(at(8), at(10), 0), chrono.RelationMeets, false, true},
+		{chrono.IntervalOfStartEnd(at(8), at(12), 0), chrono.RelationOverlaps, true, false},
+		{chrono.IntervalOfStartEnd(at(8), at(14), 0), chrono.RelationFinishedBy, true, false},
+		{chrono.IntervalOfStartEnd(at(8), at(16), 0), chrono.RelationContains, true, false},
+		{chrono.IntervalOfStartEnd(at(10), at(12), 0), chrono.RelationStarts, true, false},
+		{chrono.IntervalOfStartEnd(at(10), at(14), 0), chrono.RelationEquals, true, false},
+		{chrono.IntervalOfStartEnd(at(10), at(16), 0), chrono.RelationStartedBy, true, false},
+		{chrono.IntervalOfStartEnd(at(11), at(13), 0), chrono.RelationDuring, true, false},
+		{chrono.IntervalOfStartEnd(at(12), at(14), 0), chrono.RelationFinishes, true, false},
+		{chrono.IntervalOfStartEnd(at(12), at(16), 0), chrono.RelationOverlappedBy, true, false},
+		{chrono.IntervalOfStartEnd(at(14), at(16), 0), chrono.RelationMetBy, false, true},
+		{chrono.IntervalOfStartEnd(at(16), at(18), 0), chrono.RelationAfter, false, false},
+		{chrono.IntervalOfStartDuration(at(12), chrono.Period{}, chrono.DurationOf(4*chrono.Hour), 0), chrono.RelationOverlappedBy, true, false},
+		{chrono.IntervalOfStartEnd(chrono.OffsetDateTimeOf(2026, chrono.January, 1, 16, 0, 0, 0, 2, 0), at(18), 0), chrono.RelationMetBy, false, true},
+	} {
+		t.Run(tt.expected.String(), func(t *testing.T) {
+			if r, err := tt.x.Relation(y); err != nil || r != tt.expected {
+				t.Errorf("x.Relation(y) = %v, %v, want %v, nil", r, err, tt.expected)
+			}
+
+			if ok, err := tt.x.Overlaps(y); err != nil || ok != tt.overlaps {
+				t.Errorf("x.Overlaps(y) = %t, %v, want %t, nil", ok, err, tt.overlaps)
+			}
+
+			if ok, err := tt.x.Abuts(y); err != nil || ok != tt.abuts {
+				t.Errorf("x.Abuts(y) = %t, %v, want %t, nil", ok, err, tt.abuts)
+			}
+		})
+	}
+
+	t.Run("duration only", func(t *testing.T) {
+		i, _ := chrono.ParseInterval("PT1H")
+		if _, err := i.Relation(y); err != chrono.ErrUnsupportedRepresentation {
+			t.Errorf("i.Relation(y) = %v, want %v", err, chrono.ErrUnsupportedRepresentation)
+		}
+
+		if _, err := y.Overlaps(i); err != chrono.ErrUnsupportedRepresentation {
+			t.Errorf("y.Overlaps(i) = %v, want %v", err, chrono.ErrUnsupportedRepresentation)
+		}
+
+		if _, err := i.Contains(at(12)); err != chrono.ErrUnsupportedRepresentation {
+			t.Errorf("i.Contains() = %v, want %v", err, chrono.ErrUnsupportedRepresentation)
+		}
+	})
+}
+
+func TestInterval_Contains(t *testing.T) {
+	i, _ := chrono.ParseInterval("2026-01-01T10:00:00Z/PT4H")
+	for _, tt := range []struct {
+		name     string
+		t        chrono.OffsetDateTime
+		expected bool
+	}{
+		{"before", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 9, 59, 59, 999999999, 0, 0), false},
+		{"start", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 10, 0, 0, 0, 0, 0), true},
+		{"within", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 12, 0, 0, 0, 0, 0), true},
+		{"end", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 14, 0, 0, 0, 0, 0), false},
+		{"within at offset", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 15, 0, 0, 0, 2, 0), true},
+		{"after at offset", chrono.OffsetDateTimeOf(2026, chrono.January, 1, 12, 0, 0, 0, -2, 0), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok, err := i.Contains(tt.t); err != nil || ok != tt.expected {
+				t.Errorf("i.Contains(%v) = %t, %v, want %t, nil", tt.t, ok, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestInterval_Intersection(t *testing.T) {
+	for _, tt := range []struct {
+		i, j         string
+		intersection string
+		union        string
+		gap          string
+	}{
+		{"2026-01-01T10:00:00Z/2026-01-01T14:00:00Z", "2026-01-01T12:00:00Z/2026-01-01T16:00:00Z", "2026-01-01T12:00:00Z/2026-01-01T14:00:00Z", "2026-01-01T10:00:00Z/2026-01-01T16:00:00Z", ""},
+		{"2026-01-01T10:00:00Z/2026-01-01T14:00:00Z", "2026-01-01T11:00:00Z/PT1H", "2026-01-01T11:00:00Z/2026-01-01T12:00:00Z", "2026-01-01T10:00:00Z/2026-01-01T14:00:00Z", ""},
+		{"2026-01-01T10:00:00Z/2026-01-01T14:00:00Z", "2026-01-01T14:00:00Z/2026-01-01T16:00:00Z", "", "2026-01-01T10:00:00Z/2026-01-01T16:00:00Z", ""},
+		{"2026-01-01T16:00:00Z/2026-01-01T18:00:00Z", "2026-01-01T10:00:00Z/2026-01-01T14:00:00Z", "", "", "2026-01-01T14:00:00Z/2026-01-01T16:00:00Z"},
+	} {
+		t.Run(tt.i+" "+tt.j, func(t *testing.T) {
+			i, _ := chrono.ParseInterval(tt.i)
+			j, _ := chrono.ParseInterval(tt.j)
+
+			check := func(name string, out chrono.Interval, err error, expected string, emptyErr error) {
+				if expected == "" {
+					if err != emptyErr {
+						t.Errorf("i.%s(j) = %v, %v, want %v", name, out, err, emptyErr)
+					}
+				} else if err != nil || out.String() != expected {
+					t.Errorf("i.%s(j) = %v, %v, want %s, nil", name, out, err, expected)
+				}
+			}
+
+			out, err := i.Intersection(j)
+			check("Intersection", out, err, tt.intersection, chrono.ErrEmptyInterval)
+
+			out, err = i.Union(j)
+			check("Union", out, err, tt.union, chrono.ErrUnsupportedRepresentation)
+
+			out, err = i.Gap(j)
+			check("Gap", out, err, tt.gap, chrono.ErrEmptyInterval)
+		})
+	}
+}
+
+func TestInterval_Split(t *testing.T) {
+	i, err := chrono.ParseInterval("2026-01-15T10:00:00Z/2026-03-02T08:00:00Z")
+	if err != nil {
+		t.Fatalf("failed to parse interval: %v", err)
+	}
+
+	for _, tt := range []struct {
+		unit      chrono.Unit
+		offset    chrono.Offset
+		expected  []string
+		durations []chrono.Duration
+	}{
+		{
+			unit:   chrono.UnitQuarter,
+			offset: chrono.UTC,
+			expected: []string{
+				"2026-01-15T10:00:00Z/2026-03-02T08:00:00Z",
+			},
+			durations: []chrono.Duration{chrono.DurationOf(1102 * chrono.Hour)},
+		},
+		{
+			unit:   chrono.UnitMonth,
+			offset: chrono.UTC,
+			expected: []string{
+				"2026-01-15T10:00:00Z/2026-02-01T00:00:00Z",
+				"2026-02-01T00:00:00Z/2026-03-01T00:00:00Z",
+				"2026-03-01T00:00:00Z/2026-03-02T08:00:00Z",
+			},
+			durations: []chrono.Duration{
+				chrono.DurationOf(398 * chrono.Hour),
+				chrono.DurationOf(672 * chrono.Hour),
+				chrono.DurationOf(32 * chrono.Hour),
+			},
+		},
+		{
+			unit:   chrono.UnitWeek,
+			offset: chrono.UTC,
+			expected: []string{
+				"2026-01-15T10:00:00Z/2026-01-19T00:00:00Z",
+				"2026-01-19T00:00:00Z/2026-01-26T00:00:00Z",
+				"2026-01-26T00:00:00Z/2026-02-02T00:00:00Z",
+				"2026-02-02T00:00:00Z/2026-02-09T00:00:00Z",
+				"2026-02-09T00:00:00Z/2026-02-16T00:00:00Z",
+				"2026-02-16T00:00:00Z/2026-02-23T00:00:00Z",
+				"2026-02-23T00:00:00Z/2026-03-02T00:00:00Z",
+				"2026-03-02T00:00:00Z/2026-03-02T08:00:00Z",
+			},
+		},
+		{
+			unit:   chrono.UnitMonth,
+			offset: chrono.OffsetOf(-5, 0),
+			expected: []string{
+				"2026-01-15T05:00:00-05:00/2026-02-01T00:00:00-05:00",
+				"2026-02-01T00:00:00-05:00/2026-03-01T00:00:00-05:00",
+				"2026-03-01T00:00:00-05:00/2026-03-02T03:00:00-05:00",
+			},
+			durations: []chrono.Duration{
+				chrono.DurationOf(403 * chrono.Hour),
+				chrono.DurationOf(672 * chrono.Hour),
+				chrono.DurationOf(27 * chrono.Hour),
+			},
+		},
+	} {
+		t.Run(tt.unit.String()+" "+tt.offset.String(), func(t *testing.T) {
+			out, err := i.Split(tt.unit, tt.offset)
+			if err != nil {
+				t.Fatalf("i.Split() error = %v", err)
+			} else if len(out) != len(tt.expected) {
+				t.Fatalf("i.Split() = %v, want %v", out, tt.expected)
+			}
+
+			for n, sub := range out {
+				if str := sub.String(); str != tt.expected[n] {
+					t.Errorf("i.Split()[%d] = %v, want %v", n, str, tt.expected[n])
+				}
+
+				if tt.durations != nil {
+					if _, d, err := sub.Duration(); err != nil || d.Compare(tt.durations[n]) != 0 {
+						t.Errorf("i.Split()[%d].Duration() = %v, %v, want %v", n, d, err, tt.durations[n])
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("day with offset", func(t *testing.T) {
+		i := chrono.IntervalOfStartEnd(
+			chrono.OffsetDateTimeOf(2026, chrono.January, 1, 20, 0, 0, 0, 0, 0),
+			chrono.OffsetDateTimeOf(2026, chrono.January, 2, 4, 0, 0, 0, 0, 0), 0)
+
+		out, err := i.Split(chrono.UnitDay, chrono.OffsetOf(2, 0))
+		if err != nil {
+			t.Fatalf("i.Split() error = %v", err)
+		} else if len(out) != 2 {
+			t.Fatalf("i.Split() = %v, want 2 intervals", out)
+		} else if str := out[0].String(); str != "2026-01-01T22:00:00+02:00/2026-01-02T00:00:00+02:00" {
+			t.Errorf("i.Split()[0] = %v", str)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		start := chrono.OffsetDateTimeOf(2026, chrono.January, 1, 0, 0, 0, 0, 0, 0)
+		if out, err := chrono.IntervalOfStartEnd(start, start, 0).Split(chrono.UnitDay, chrono.UTC); err != nil || out != nil {
+			t.Errorf("i.Split() = %v, %v, want nil, nil", out, err)
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		if _, err := chrono.IntervalOfStartDuration(chrono.OffsetDateTime{}, chrono.Period{}, chrono.DurationOf(chrono.Hour), 0).
+			Split(chrono.Unit(0), chrono.UTC); err == nil {
+			t.Error("expecting error, got nil")
+		}
+	})
+}
+
+func TestInterval_SplitIn(t *testing.T) {
+	offsets, err := chrono.ZoneOffsets("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+
+	for _, tt := range []struct {
+		name      string
+		interval  string
+		unit      chrono.Unit
+		expected  []string
+		durations []chrono.Duration
+	}{
+		{
+			name:     "spring forward",
+			interval: "2026-03-28T00:00:00+01:00/2026-03-30T00:00:00+02:00",
+			unit:     chrono.UnitDay,
+			expected: []string{
+				"2026-03-28T00:00:00+01:00/2026-03-29T00:00:00+01:00",
+				"2026-03-29T00:00:00+01:00/2026-03-30T00:00:00+02:00",
+			},
+			durations: []chrono.Duration{
+				chrono.DurationOf(24 * chrono.Hour),
+				chrono.DurationOf(23 * chrono.Hour),
+			},
+		},
+		{
+			name:     "fall back",
+			interval: "2026-10-24T22:00:00Z/2026-10-26T23:00:00Z",
+			unit:     chrono.UnitDay,
+			expected: []string{
+				"2026-10-25T00:00:00+02:00/2026-10-26T00:00:00+01:00",
+				"2026-10-26T00:00:00+01:00/2026-10-27T00:00:00+01:00",
+			},
+			durations: []chrono.Duration{
+				chrono.DurationOf(25 * chrono.Hour),
+				chrono.DurationOf(24 * chrono.Hour),
+			},
+		},
+		{
+			name:     "skipped hour",
+			interval: "2026-03-29T01:00:00+01:00/2026-03-29T04:00:00+02:00",
+			unit:     chrono.UnitHour,
+			expected: []string{
+				"2026-03-29T01:00:00+01:00/2026-03-29T03:00:00+02:00",
+				"2026-03-29T03:00:00+02:00/2026-03-29T04:00:00+02:00",
+			},
+			durations: []chrono.Duration{
+				chrono.DurationOf(chrono.Hour),
+				chrono.DurationOf(chrono.Hour),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			i, err := chrono.ParseInterval(tt.interval)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			}
+
+			out, err := i.SplitIn(tt.unit, offsets)
+			if err != nil {
+				t.Fatalf("i.SplitIn() error = %v", err)
+			} else if len(out) != len(tt.expected) {
+				t.Fatalf("i.SplitIn() = %v, want %v", out, tt.expected)
+			}
+
+			for n, sub := range out {
+				if str := sub.String(); str != tt.expected[n] {
+					t.Errorf("i.SplitIn()[%d] = %v, want %v", n, str, tt.expected[n])
+				}
+
+				if _, d, err := sub.Duration(); err != nil || d.Compare(tt.durations[n]) != 0 {
+					t.Errorf("i.SplitIn()[%d].Duration() = %v, %v, want %v", n, d, err, tt.durations[n])
+				}
+			}
+		})
+	}
+}
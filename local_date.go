@@ -1,5 +1,7 @@
 package chrono
 
+import "fmt"
+
 // LocalDate is a date without a time zone or time component, according to ISO 8601.
 // It represents a year-month-day in the proleptic Gregorian calendar,
 // but cannot represent an instant on a timeline without additional time offset information.
@@ -114,6 +116,8 @@ func (d LocalDate) ISOWeek() (isoYear, isoWeek int) {
 }
 
 // AddDate returns the date corresponding to adding the given number of years, months, and days to d.
+// A day that does not exist in the resulting month carries into the following month, as described by EndOfMonthOverflow.
+// Use AddDateWith to resolve it differently.
 func (d LocalDate) AddDate(years, months, days int) LocalDate {
 	out, err := addDateToDate(int64(d), years, months, days)
 	if err != nil {
@@ -128,11 +132,94 @@ func (d LocalDate) CanAddDate(years, months, days int) bool {
 	return err == nil
 }
 
+// AddDateWith returns the date corresponding to adding the given number of years, months, and days to d,
+// where eom specifies how to resolve a day that does not exist in the month reached by adding the years and months.
+// The days are added after the day has been resolved.
+// This function panics if the resulting date would fall outside of the allowed range,
+// or if the day does not exist and eom is EndOfMonthError.
+func (d LocalDate) AddDateWith(years, months, days int, eom EndOfMonth) LocalDate {
+	out, err := addDateToDateWith(int64(d), years, months, days, eom)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDate(out)
+}
+
+// CanAddDateWith returns false if AddDateWith would panic if passed the same arguments.
+func (d LocalDate) CanAddDateWith(years, months, days int, eom EndOfMonth) bool {
+	_, err := addDateToDateWith(int64(d), years, months, days, eom)
+	return err == nil
+}
+
+// AddPeriod returns the date corresponding to adding the period p to d.
+// Weeks and fractional components are applied as described by [Period],
+// and any remaining fraction of a day is truncated toward zero.
+// This function panics if the resulting date would fall outside of the allowed range.
+func (d LocalDate) AddPeriod(p Period) LocalDate {
+	return d.AddPeriodDuration(p, Duration{})
+}
+
+// CanAddPeriod returns false if AddPeriod would panic if passed the same argument.
+func (d LocalDate) CanAddPeriod(p Period) bool {
+	return d.CanAddPeriodDuration(p, Duration{})
+}
+
+// AddPeriodDuration returns the date corresponding to adding the period p, followed by the duration v, to d.
+// Since a date cannot represent a fraction of a day, the sum of v and any fraction of a day remaining from p
+// is truncated toward zero to a whole number of days.
+// This function panics if the resulting date would fall outside of the allowed range.
+func (d LocalDate) AddPeriodDuration(p Period, v Duration) LocalDate {
+	out, err := addPeriodDurationToDate(int64(d), p, v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDate(out)
+}
+
+// CanAddPeriodDuration returns false if AddPeriodDuration would panic if passed the same arguments.
+func (d LocalDate) CanAddPeriodDuration(p Period, v Duration) bool {
+	_, err := addPeriodDurationToDate(int64(d), p, v)
+	return err == nil
+}
+
+// Until returns the period between d and end as a number of years, months and days.
+// The whole months are counted first, and the remaining days are then counted from the date reached by adding
+// those months to d, where the day is clamped to the last day of the month if required. For example,
+// the period from 31st January to 1st March 2021 is P1M1D, as 31st January plus one month is clamped to 28th February.
+// The years, months and days are all negative if end is before d.
+func (d LocalDate) Until(end LocalDate) Period {
+	out, err := periodBetweenDates(int64(d), int64(end))
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// String returns a string formatted according to ISO 8601.
+// Years outside of the range 0000 to 9999 are formatted as described for %nY.
 func (d LocalDate) String() string {
 	year, month, day := d.Date()
 	return simpleDateStr(year, int(month), day)
 }
 
+// GoString returns the Go syntax that represents d, e.g. chrono.LocalDateOf(2006, chrono.April, 9).
+func (d LocalDate) GoString() string {
+	year, month, day := d.Date()
+	return fmt.Sprintf("chrono.LocalDateOf(%d, %s, %d)", year, goSyntaxMonth(int(month)), day)
+}
+
+// Formatter returns a [Formatter] for d, which supports the verbose %+v representation, padding and truncation.
+func (d LocalDate) Formatter() Formatter {
+	return Formatter{
+		typ: "LocalDate",
+		str: d.String,
+		verbose: func() string {
+			return fmt.Sprintf("chrono.LocalDate(%s, jdn=%d)", d, int64(d)+unixEpochJDN)
+		},
+		goValue: d.GoString,
+	}
+}
+
 // Format returns a textual representation of the date value formatted according to the layout defined by the argument.
 // See the constants section of the documentation to see how to represent the layout format.
 // Time format specifiers encountered in the layout results in a panic.
@@ -148,13 +235,31 @@ func (d LocalDate) Format(layout string) string {
 // See the constants section of the documentation to see how to represent the layout format.
 // Time format specifiers encountered in the layout results in a panic.
 func (d *LocalDate) Parse(layout, value string) error {
+	_, err := d.ParseWith(layout, value, 0)
+	return err
+}
+
+// ParseWith behaves the same as Parse, except that the behavior of parsing is modified by opts.
+// The number of bytes of value that were consumed is returned, which is less than len(value)
+// only if ParseTrailingText is used.
+func (d *LocalDate) ParseWith(layout, value string, opts ParseOption) (int, error) {
 	v := int64(*d)
-	if err := parseDateAndTime(layout, value, &v, nil, nil); err != nil {
-		return err
+	n, err := parseDateAndTimeWith(layout, value, opts, &v, nil, nil)
+	if err != nil {
+		return n, err
 	}
 
 	*d = LocalDate(v)
-	return nil
+	return n, nil
+}
+
+func (d LocalDate) get() (dv, tv, ov *int64) {
+	v := int64(d)
+	return &v, nil, nil
+}
+
+func (d *LocalDate) set(dv, tv, ov int64) {
+	*d = LocalDate(dv)
 }
 
 // MinLocalDate returns the earliest supported date.
@@ -0,0 +1,223 @@
+package chrono
+
+import "fmt"
+
+// LocalDateInterval represents the intervening dates between two dates, such as 2026-01-01/2026-01-31.
+// It is the equivalent of [Interval] for [LocalDate] endpoints, whose durations are expressed as a [Period].
+type LocalDateInterval struct {
+	s  *LocalDate
+	e  *LocalDate
+	p  *Period
+	r  int
+	sk IntervalEndpoint
+	ek IntervalEndpoint
+}
+
+// LocalDateIntervalOfStartEnd creates a [LocalDateInterval] from the provided start and end dates.
+func LocalDateIntervalOfStartEnd(start, end LocalDate, repetitions int) LocalDateInterval {
+	return LocalDateInterval{s: &start, e: &end, r: repetitions}
+}
+
+// LocalDateIntervalOfStartPeriod creates a [LocalDateInterval] from the provided start date and period.
+func LocalDateIntervalOfStartPeriod(start LocalDate, period Period, repetitions int) LocalDateInterval {
+	return LocalDateInterval{s: &start, p: &period, r: repetitions}
+}
+
+// LocalDateIntervalOfPeriodEnd creates a [LocalDateInterval] from the provided period and end date.
+func LocalDateIntervalOfPeriodEnd(period Period, end LocalDate, repetitions int) LocalDateInterval {
+	return LocalDateInterval{e: &end, p: &period, r: repetitions}
+}
+
+// LocalDateIntervalOfStartOpenEnd creates a [LocalDateInterval] from the provided start date, and an end that is either
+// [EndpointOpen] or [EndpointUnknown]. If any other endpoint is provided, this function panics.
+func LocalDateIntervalOfStartOpenEnd(start LocalDate, end IntervalEndpoint, repetitions int) LocalDateInterval {
+	if end != EndpointOpen && end != EndpointUnknown {
+		panic("invalid endpoint")
+	}
+	return LocalDateInterval{s: &start, ek: end, r: repetitions}
+}
+
+// LocalDateIntervalOfOpenStartEnd creates a [LocalDateInterval] from the provided end date, and a start that is either
+// [EndpointOpen] or [EndpointUnknown]. If any other endpoint is provided, this function panics.
+func LocalDateIntervalOfOpenStartEnd(start IntervalEndpoint, end LocalDate, repetitions int) LocalDateInterval {
+	if start != EndpointOpen && start != EndpointUnknown {
+		panic("invalid endpoint")
+	}
+	return LocalDateInterval{e: &end, sk: start, r: repetitions}
+}
+
+// ParseLocalDateInterval parses an ISO 8601 date interval, or a repeating date interval.
+// It accepts the same forms as [ParseInterval], except that <start> and <end> are dates formatted as [ISO8601DateExtended],
+// and <duration> must not include a time component.
+//
+// The end date may be reduced by omitting its higher-order components, in which case they are taken from the start date.
+// For example, 2026-02-15/28 is equivalent to 2026-02-15/2026-02-28, and 2026-02-15/03-01 is equivalent to 2026-02-15/2026-03-01.
+func ParseLocalDateInterval(s string) (LocalDateInterval, error) {
+	parts, err := parseIntervalParts(s)
+	if err != nil {
+		return LocalDateInterval{}, err
+	}
+
+	out := LocalDateInterval{r: parts.repeat}
+	if parts.pd != nil {
+		if parts.pd.Duration.Compare(Duration{}) != 0 {
+			return LocalDateInterval{}, fmt.Errorf("invalid interval: duration has a time component")
+		}
+		out.p = &parts.pd.Period
+	}
+
+	if parts.sk == EndpointTime {
+		if out.s, err = parseIntervalDate(parts.start); err != nil {
+			return LocalDateInterval{}, err
+		}
+	} else {
+		out.sk = parts.sk
+	}
+
+	if parts.ek == EndpointTime {
+		if parts.sk == EndpointTime {
+			parts.end = completeReducedEnd(parts.start, parts.end)
+		}
+
+		if out.e, err = parseIntervalDate(parts.end); err != nil {
+			return LocalDateInterval{}, err
+		}
+	} else {
+		out.ek = parts.ek
+	}
+	return out, nil
+}
+
+func parseIntervalDate(value string) (*LocalDate, error) {
+	var date int64
+	if err := parseDateAndTime(expandedYearLayout(ISO8601DateExtended, value), value, &date, nil, nil); err != nil {
+		return nil, err
+	}
+
+	out := LocalDate(date)
+	return &out, nil
+}
+
+// String returns the formatted LocalDateInterval that can be parsed by [ParseLocalDateInterval].
+// The end date is never reduced.
+func (i LocalDateInterval) String() string {
+	parts := intervalParts{sk: i.sk, ek: i.ek, repeat: i.Repetitions()}
+	if i.s != nil {
+		parts.start, parts.sk = i.s.String(), EndpointTime
+	}
+
+	if i.e != nil {
+		parts.end, parts.ek = i.e.String(), EndpointTime
+	}
+
+	var period string
+	if i.p != nil {
+		period = i.p.String()
+	}
+	return parts.stringWith("/", period)
+}
+
+// StartEndpoint returns how the start of i is expressed.
+func (i LocalDateInterval) StartEndpoint() IntervalEndpoint {
+	if i.s != nil {
+		return EndpointTime
+	}
+	return i.sk
+}
+
+// EndEndpoint returns how the end of i is expressed.
+func (i LocalDateInterval) EndEndpoint() IntervalEndpoint {
+	if i.e != nil {
+		return EndpointTime
+	}
+	return i.ek
+}
+
+// Start returns the start date if present, or a calculated date if possible by subtracting i.Period() from i.End().
+// If neither are possible (i.e. only a period is present), [ErrUnsupportedRepresentation] is returned instead.
+// If the start is open or unknown, [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
+func (i LocalDateInterval) Start() (LocalDate, error) {
+	switch {
+	case i.s != nil:
+		return *i.s, nil
+	case i.sk != EndpointAbsent:
+		return 0, endpointErr(i.sk)
+	case i.e != nil:
+		p, err := i.p.mul(-1)
+		if err != nil {
+			return 0, err
+		}
+
+		v, err := addPeriodDurationToDate(int64(*i.e), p, Duration{})
+		if err != nil {
+			return 0, err
+		}
+		return LocalDate(v), nil
+	default:
+		return 0, ErrUnsupportedRepresentation
+	}
+}
+
+// End returns the end date if present, or a calculated date if possible by adding i.Period() to i.Start().
+// If neither are possible (i.e. only a period is present), [ErrUnsupportedRepresentation] is returned instead.
+// If the end is open or unknown, [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
+func (i LocalDateInterval) End() (LocalDate, error) {
+	switch {
+	case i.e != nil:
+		return *i.e, nil
+	case i.ek != EndpointAbsent:
+		return 0, endpointErr(i.ek)
+	case i.s != nil:
+		v, err := addPeriodDurationToDate(int64(*i.s), *i.p, Duration{})
+		if err != nil {
+			return 0, err
+		}
+		return LocalDate(v), nil
+	default:
+		return 0, ErrUnsupportedRepresentation
+	}
+}
+
+// Period returns the [Period] if present, or a calculated [Period] if possible as by [PeriodBetween].
+// If the start or end is open or unknown, [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
+func (i LocalDateInterval) Period() (Period, error) {
+	switch {
+	case i.p != nil:
+		return *i.p, nil
+	case i.sk != EndpointAbsent:
+		return Period{}, endpointErr(i.sk)
+	case i.ek != EndpointAbsent:
+		return Period{}, endpointErr(i.ek)
+	case i.s != nil && i.e != nil:
+		return periodBetweenDates(int64(*i.s), int64(*i.e))
+	default:
+		return Period{}, ErrUnsupportedRepresentation
+	}
+}
+
+// Repetitions returns the number of repetitions of a repeating interval.
+// Any negative number, meaning an unbounded number of repitions, is normalized to -1.
+func (i LocalDateInterval) Repetitions() int {
+	if i.r <= -1 {
+		return -1
+	}
+	return i.r
+}
+
+// In returns the [Interval] that represents i at the specified offset, where each date is taken to start at midnight.
+// Thus, the end of the returned interval is the start of the end date, in the same way as the end date is excluded by [LocalDateInterval.Period].
+func (i LocalDateInterval) In(offset Offset) Interval {
+	out := Interval{r: i.r, sk: i.sk, ek: i.ek}
+	if i.p != nil {
+		out.d = &periodDuration{Period: *i.p}
+	}
+
+	if i.s != nil {
+		out.s = &OffsetDateTime{v: dateTime{date: int64(*i.s)}, o: int64(offset)}
+	}
+
+	if i.e != nil {
+		out.e = &OffsetDateTime{v: dateTime{date: int64(*i.e)}, o: int64(offset)}
+	}
+	return out
+}
@@ -0,0 +1,190 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestParseLocalDateInterval(t *testing.T) {
+	for _, tt := range []struct {
+		str       string
+		formatted string
+		start     chrono.LocalDate
+		end       chrono.LocalDate
+		period    chrono.Period
+		reps      int
+	}{
+		{
+			str:       "2026-01-01/2026-01-31",
+			formatted: "2026-01-01/2026-01-31",
+			start:     chrono.LocalDateOf(2026, chrono.January, 1),
+			end:       chrono.LocalDateOf(2026, chrono.January, 31),
+			period:    chrono.Period{Days: 30},
+		},
+		{
+			str:       "2026-01-01/P1M",
+			formatted: "2026-01-01/P1M",
+			start:     chrono.LocalDateOf(2026, chrono.January, 1),
+			end:       chrono.LocalDateOf(2026, chrono.February, 1),
+			period:    chrono.Period{Months: 1},
+		},
+		{
+			str:       "2026-01-31/P1M",
+			formatted: "2026-01-31/P1M",
+			start:     chrono.LocalDateOf(2026, chrono.January, 31),
+			end:       chrono.LocalDateOf(2026, chrono.March, 3),
+			period:    chrono.Period{Months: 1},
+		},
+		{
+			str:       "P1M/2026-03-28",
+			formatted: "P1M/2026-03-28",
+			start:     chrono.LocalDateOf(2026, chrono.February, 28),
+			end:       chrono.LocalDateOf(2026, chrono.March, 28),
+			period:    chrono.Period{Months: 1},
+		},
+		{
+			str:       "2026-02-15/28",
+			formatted: "2026-02-15/2026-02-28",
+			start:     chrono.LocalDateOf(2026, chrono.February, 15),
+			end:       chrono.LocalDateOf(2026, chrono.February, 28),
+			period:    chrono.Period{Days: 13},
+		},
+		{
+			str:       "2026-02-15/03-14",
+			formatted: "2026-02-15/2026-03-14",
+			start:     chrono.LocalDateOf(2026, chrono.February, 15),
+			end:       chrono.LocalDateOf(2026, chrono.March, 14),
+			period:    chrono.Period{Days: 27},
+		},
+		{
+			str:       "-0044-03-15/16",
+			formatted: "-0044-03-15/-0044-03-16",
+			start:     chrono.LocalDateOf(-44, chrono.March, 15),
+			end:       chrono.LocalDateOf(-44, chrono.March, 16),
+			period:    chrono.Period{Days: 1},
+		},
+		{
+			str:       "+10000-01-01/P1M",
+			formatted: "+10000-01-01/P1M",
+			start:     chrono.LocalDateOf(10000, chrono.January, 1),
+			end:       chrono.LocalDateOf(10000, chrono.February, 1),
+			period:    chrono.Period{Months: 1},
+		},
+		{
+			str:       "R3--2026-01-01--P1W",
+			formatted: "R3/2026-01-01/P1W",
+			start:     chrono.LocalDateOf(2026, chrono.January, 1),
+			end:       chrono.LocalDateOf(2026, chrono.January, 8),
+			period:    chrono.Period{Weeks: 1},
+			reps:      3,
+		},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			i, err := chrono.ParseLocalDateInterval(tt.str)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			}
+
+			if d, err := i.Start(); err != nil || d != tt.start {
+				t.Errorf("i.Start() = %v, %v, want %v, nil", d, err, tt.start)
+			}
+
+			if d, err := i.End(); err != nil || d != tt.end {
+				t.Errorf("i.End() = %v, %v, want %v, nil", d, err, tt.end)
+			}
+
+			if p, err := i.Period(); err != nil || !p.Equal(tt.period) {
+				t.Errorf("i.Period() = %v, %v, want %v, nil", p, err, tt.period)
+			}
+
+			if r := i.Repetitions(); r != tt.reps {
+				t.Errorf("i.Repetitions() = %v, want %v", r, tt.reps)
+			}
+
+			if formatted := i.String(); formatted != tt.formatted {
+				t.Errorf("i.String() = %v, want %v", formatted, tt.formatted)
+			}
+		})
+	}
+
+	t.Run("open end", func(t *testing.T) {
+		i, err := chrono.ParseLocalDateInterval("2026-01-01/..")
+		if err != nil {
+			t.Fatalf("failed to parse interval: %v", err)
+		}
+
+		if k := i.EndEndpoint(); k != chrono.EndpointOpen {
+			t.Errorf("i.EndEndpoint() = %v, want %v", k, chrono.EndpointOpen)
+		}
+
+		if _, err := i.End(); !errors.Is(err, chrono.ErrOpenEndpoint) {
+			t.Errorf("i.End() error = %v, want %v", err, chrono.ErrOpenEndpoint)
+		}
+
+		if formatted := i.String(); formatted != "2026-01-01/.." {
+			t.Errorf("i.String() = %v, want 2026-01-01/..", formatted)
+		}
+	})
+
+	for _, str := range []string{
+		"2026-01-01T00:00:00Z/2026-01-31T00:00:00Z",
+		"2026-01-01/PT12H",
+		"2026-02-15/5",
+		"2026-02-15/2-28",
+		"2026-02-15/2026-02-30",
+		"2026-01-01",
+	} {
+		t.Run(str, func(t *testing.T) {
+			if _, err := chrono.ParseLocalDateInterval(str); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestLocalDateIntervalOf(t *testing.T) {
+	start := chrono.LocalDateOf(2026, chrono.January, 31)
+	end := chrono.LocalDateOf(2026, chrono.March, 31)
+
+	for _, tt := range []struct {
+		name     string
+		interval chrono.LocalDateInterval
+		expected string
+	}{
+		{"start end", chrono.LocalDateIntervalOfStartEnd(start, end, 0), "2026-01-31/2026-03-31"},
+		{"start period", chrono.LocalDateIntervalOfStartPeriod(start, chrono.Period{Months: 2}, 2), "R2/2026-01-31/P2M"},
+		{"period end", chrono.LocalDateIntervalOfPeriodEnd(chrono.Period{Months: 2}, end, -1), "R/P2M/2026-03-31"},
+		{"start unknown", chrono.LocalDateIntervalOfStartOpenEnd(start, chrono.EndpointUnknown, 0), "2026-01-31/"},
+		{"open end", chrono.LocalDateIntervalOfOpenStartEnd(chrono.EndpointOpen, end, 0), "../2026-03-31"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if formatted := tt.interval.String(); formatted != tt.expected {
+				t.Errorf("i.String() = %v, want %v", formatted, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalDateInterval_In(t *testing.T) {
+	for _, tt := range []struct {
+		str      string
+		expected string
+	}{
+		{"2026-02-15/28", "2026-02-15T00:00:00+01:00/2026-02-28T00:00:00+01:00"},
+		{"R2/2026-02-15/P1M", "R2/2026-02-15T00:00:00+01:00/P1MT0S"},
+		{"../2026-02-28", "../2026-02-28T00:00:00+01:00"},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			i, err := chrono.ParseLocalDateInterval(tt.str)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			}
+
+			if formatted := i.In(chrono.OffsetOf(1, 0)).String(); formatted != tt.expected {
+				t.Errorf("i.In().String() = %v, want %v", formatted, tt.expected)
+			}
+		})
+	}
+}
@@ -73,6 +73,56 @@ func TestLocalDate(t *testing.T) {
 	}
 }
 
+func TestLocalDate_String(t *testing.T) {
+	for _, tt := range []struct {
+		date     chrono.LocalDate
+		expected string
+	}{
+		{chrono.LocalDateOf(2007, chrono.May, 20), "2007-05-20"},
+		{chrono.LocalDateOf(0, chrono.January, 1), "0000-01-01"},
+		{chrono.LocalDateOf(9999, chrono.December, 31), "9999-12-31"},
+		{chrono.LocalDateOf(10000, chrono.January, 1), "+10000-01-01"},
+		{chrono.LocalDateOf(-1, chrono.December, 31), "-0001-12-31"},
+		{chrono.MinLocalDate(), "-4713-11-24"},
+		{chrono.MaxLocalDate(), "+5874898-06-03"},
+	} {
+		t.Run(tt.expected, func(t *testing.T) {
+			if output := tt.date.String(); output != tt.expected {
+				t.Errorf("LocalDate.String() = %s, want %s", output, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalDate_String_roundTrip(t *testing.T) {
+	for _, tt := range []struct {
+		layout string
+		date   chrono.LocalDate
+	}{
+		{chrono.ISO8601DateExtended, chrono.LocalDateOf(9999, chrono.December, 31)},
+		{chrono.ISO8601DateExtended, chrono.LocalDateOf(-1, chrono.December, 31)},
+		{chrono.ISO8601DateExtended, chrono.MinLocalDate()},
+		{"%1Y-%m-%d", chrono.LocalDateOf(10000, chrono.January, 1)},
+		{"%3Y-%m-%d", chrono.MaxLocalDate()},
+	} {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			var date chrono.LocalDate
+			if err := date.Parse(tt.layout, tt.date.String()); err != nil {
+				t.Errorf("date.Parse(%s, %s) = %v, want nil", tt.layout, tt.date, err)
+			} else if date != tt.date {
+				t.Errorf("expecting %v, but got %v", tt.date, date)
+			}
+		})
+	}
+
+	t.Run("ISO 8601 layout after 9999", func(t *testing.T) {
+		var date chrono.LocalDate
+		if err := date.Parse(chrono.ISO8601DateExtended, "+10000-01-01"); err == nil {
+			t.Errorf("expecting error but got nil")
+		}
+	})
+}
+
 func TestLocalDateOf(t *testing.T) {
 	for _, tt := range []struct {
 		name  string
@@ -234,7 +284,10 @@ func TestLocalDate_AddDate(t *testing.T) {
 		{"sub days", chrono.LocalDateOf(2020, chrono.March, 18), 0, 0, -15, chrono.LocalDateOf(2020, chrono.March, 3)},
 		{"time package example", chrono.LocalDateOf(2011, chrono.January, 1), -1, 2, 3, chrono.LocalDateOf(2010, chrono.March, 4)},
 		{"normalized time package example", chrono.LocalDateOf(2011, chrono.October, 31), 0, 1, 0, chrono.LocalDateOf(2011, chrono.December, 1)},
+		{"end of month overflow", chrono.LocalDateOf(2026, chrono.January, 31), 0, 1, 0, chrono.LocalDateOf(2026, chrono.March, 3)},
 		{"wrap around day", chrono.LocalDateOf(2020, chrono.March, 18), 0, 0, 20, chrono.LocalDateOf(2020, chrono.April, 7)},
+		{"wrap around month", chrono.LocalDateOf(2007, chrono.March, 1), 0, 14, 0, chrono.LocalDateOf(2008, chrono.May, 1)},
+		{"wrap around month backwards", chrono.LocalDateOf(2007, chrono.March, 1), 0, -15, 0, chrono.LocalDateOf(2005, chrono.December, 1)},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			if ok := tt.date.CanAddDate(tt.addYears, tt.addMonths, tt.addDays); !ok {
@@ -272,3 +325,187 @@ func TestLocalDate_AddDate(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalDate_Until(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		start    chrono.LocalDate
+		end      chrono.LocalDate
+		expected chrono.Period
+		str      string
+	}{
+		{"same date", chrono.LocalDateOf(2020, chrono.March, 18), chrono.LocalDateOf(2020, chrono.March, 18), chrono.Period{}, "P0D"},
+		{"days", chrono.LocalDateOf(2020, chrono.March, 18), chrono.LocalDateOf(2020, chrono.March, 26), chrono.Period{Days: 8}, "P8D"},
+		{"years months days", chrono.LocalDateOf(1990, chrono.June, 15), chrono.LocalDateOf(2026, chrono.October, 17), chrono.Period{Years: 36, Months: 4, Days: 2}, "P36Y4M2D"},
+		{"borrow month", chrono.LocalDateOf(2020, chrono.January, 15), chrono.LocalDateOf(2020, chrono.March, 10), chrono.Period{Months: 1, Days: 24}, "P1M24D"},
+		{"clamped to end of month", chrono.LocalDateOf(2021, chrono.January, 31), chrono.LocalDateOf(2021, chrono.March, 1), chrono.Period{Months: 1, Days: 1}, "P1M1D"},
+		{"clamped to leap day", chrono.LocalDateOf(2020, chrono.January, 31), chrono.LocalDateOf(2020, chrono.March, 1), chrono.Period{Months: 1, Days: 1}, "P1M1D"},
+		{"end of month to end of month", chrono.LocalDateOf(2021, chrono.January, 31), chrono.LocalDateOf(2021, chrono.February, 28), chrono.Period{Days: 28}, "P28D"},
+		{"birthday on leap day", chrono.LocalDateOf(2000, chrono.February, 29), chrono.LocalDateOf(2021, chrono.February, 28), chrono.Period{Years: 20, Months: 11, Days: 30}, "P20Y11M30D"},
+		{"negative", chrono.LocalDateOf(2020, chrono.March, 10), chrono.LocalDateOf(2020, chrono.January, 15), chrono.Period{Months: -1, Days: -26}, "-P1M26D"},
+		{"reversed months", chrono.LocalDateOf(2026, chrono.March, 1), chrono.LocalDateOf(2026, chrono.January, 1), chrono.Period{Months: -2}, "-P2M"},
+		{"reversed end of month", chrono.LocalDateOf(2021, chrono.March, 1), chrono.LocalDateOf(2021, chrono.January, 31), chrono.Period{Months: -1, Days: -1}, "-P1M1D"},
+		{"negative years", chrono.LocalDateOf(2026, chrono.October, 17), chrono.LocalDateOf(1990, chrono.June, 15), chrono.Period{Years: -36, Months: -4, Days: -2}, "-P36Y4M2D"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if p := tt.start.Until(tt.end); p != tt.expected {
+				t.Errorf("%s.Until(%s) = %v, want %v", tt.start, tt.end, p, tt.expected)
+			}
+
+			if p := chrono.PeriodBetween(tt.start, tt.end); p != tt.expected {
+				t.Errorf("PeriodBetween(%s, %s) = %v, want %v", tt.start, tt.end, p, tt.expected)
+			} else if str := p.String(); str != tt.str {
+				t.Errorf("PeriodBetween(%s, %s).String() = %s, want %s", tt.start, tt.end, str, tt.str)
+			}
+		})
+	}
+}
+
+func TestLocalDate_AddPeriod(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		date     chrono.LocalDate
+		period   chrono.Period
+		duration chrono.Duration
+		expected chrono.LocalDate
+	}{
+		{"nothing", chrono.LocalDateOf(2020, chrono.March, 18), chrono.Period{}, chrono.Duration{}, chrono.LocalDateOf(2020, chrono.March, 18)},
+		{"years months days", chrono.LocalDateOf(2020, chrono.March, 18), chrono.Period{Years: 1, Months: 2, Days: 3}, chrono.Duration{}, chrono.LocalDateOf(2021, chrono.May, 21)},
+		{"weeks", chrono.LocalDateOf(2020, chrono.March, 18), chrono.Period{Weeks: 2, Days: 1}, chrono.Duration{}, chrono.LocalDateOf(2020, chrono.April, 2)},
+		{"negative", chrono.LocalDateOf(2020, chrono.March, 18), chrono.Period{Months: -1, Weeks: -1}, chrono.Duration{}, chrono.LocalDateOf(2020, chrono.February, 11)},
+		{"fractional year", chrono.LocalDateOf(2020, chrono.January, 1), chrono.Period{Years: 1, YearsFraction: 500000000}, chrono.Duration{}, chrono.LocalDateOf(2021, chrono.July, 1)},
+		{"fractional month", chrono.LocalDateOf(2020, chrono.January, 1), chrono.Period{Months: 1, MonthsFraction: 500000000}, chrono.Duration{}, chrono.LocalDateOf(2020, chrono.February, 15)},
+		{"fractional day truncated", chrono.LocalDateOf(2020, chrono.January, 1), chrono.Period{Days: 1, DaysFraction: 900000000}, chrono.Duration{}, chrono.LocalDateOf(2020, chrono.January, 2)},
+		{"negative fractional day truncated", chrono.LocalDateOf(2020, chrono.January, 10), chrono.Period{Days: -1, DaysFraction: -900000000}, chrono.Duration{}, chrono.LocalDateOf(2020, chrono.January, 9)},
+		{"duration", chrono.LocalDateOf(2020, chrono.January, 1), chrono.Period{Days: 1}, chrono.DurationOf(47 * chrono.Hour), chrono.LocalDateOf(2020, chrono.January, 3)},
+		{"duration completes day", chrono.LocalDateOf(2020, chrono.January, 1), chrono.Period{DaysFraction: 500000000}, chrono.DurationOf(12 * chrono.Hour), chrono.LocalDateOf(2020, chrono.January, 2)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.date.CanAddPeriodDuration(tt.period, tt.duration); !ok {
+				t.Errorf("%s.CanAddPeriodDuration(%v, %v) = false, want true", tt.date, tt.period, tt.duration)
+			}
+
+			if date := tt.date.AddPeriodDuration(tt.period, tt.duration); date != tt.expected {
+				t.Errorf("%s.AddPeriodDuration(%v, %v) = %s, want %s", tt.date, tt.period, tt.duration, date, tt.expected)
+			}
+
+			if tt.duration == (chrono.Duration{}) {
+				if date := tt.date.AddPeriod(tt.period); date != tt.expected {
+					t.Errorf("%s.AddPeriod(%v) = %s, want %s", tt.date, tt.period, date, tt.expected)
+				}
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		input    string
+		expected chrono.LocalDate
+	}{
+		{"-P1M", chrono.LocalDateOf(2026, chrono.February, 28)},
+		{"-P1Y2W", chrono.LocalDateOf(2025, chrono.March, 14)},
+		{"+P1D", chrono.LocalDateOf(2026, chrono.March, 29)},
+	} {
+		t.Run("parsed "+tt.input, func(t *testing.T) {
+			var p chrono.Period
+			if err := p.Parse(tt.input); err != nil {
+				t.Fatalf("failed to parse period: %v", err)
+			}
+
+			date := chrono.LocalDateOf(2026, chrono.March, 28)
+			if out := date.AddPeriod(p); out != tt.expected {
+				t.Errorf("%s.AddPeriod(%v) = %s, want %s", date, p, out, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name   string
+		date   chrono.LocalDate
+		period chrono.Period
+	}{
+		{"underflow", chrono.MinLocalDate(), chrono.Period{Days: -1}},
+		{"overflow", chrono.MaxLocalDate(), chrono.Period{Weeks: 1}},
+		{"out of range", chrono.LocalDateOf(2020, chrono.January, 1), chrono.Period{Years: maxInt}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.date.CanAddPeriod(tt.period); ok {
+				t.Errorf("%s.CanAddPeriod(%v) = true, want false", tt.date, tt.period)
+			}
+
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Error("expecting panic that didn't occur")
+					}
+				}()
+
+				tt.date.AddPeriod(tt.period)
+			}()
+		})
+	}
+}
+
+func TestLocalDate_AddDateWith(t *testing.T) {
+	jan31 := chrono.LocalDateOf(2021, chrono.January, 31)
+	jan30 := chrono.LocalDateOf(2021, chrono.January, 30)
+	feb28 := chrono.LocalDateOf(2021, chrono.February, 28)
+
+	for _, tt := range []struct {
+		name      string
+		date      chrono.LocalDate
+		addYears  int
+		addMonths int
+		addDays   int
+		eom       chrono.EndOfMonth
+		expected  chrono.LocalDate
+	}{
+		{"overflow", jan31, 0, 1, 0, chrono.EndOfMonthOverflow, chrono.LocalDateOf(2021, chrono.March, 3)},
+		{"overflow leap year", chrono.LocalDateOf(2020, chrono.January, 31), 0, 1, 0, chrono.EndOfMonthOverflow, chrono.LocalDateOf(2020, chrono.March, 2)},
+		{"clamp", jan31, 0, 1, 0, chrono.EndOfMonthClamp, feb28},
+		{"clamp leap day", chrono.LocalDateOf(2020, chrono.February, 29), 1, 0, 0, chrono.EndOfMonthClamp, feb28},
+		{"clamp then add days", jan31, 0, 1, 1, chrono.EndOfMonthClamp, chrono.LocalDateOf(2021, chrono.March, 1)},
+		{"clamp not at end", jan30, 0, 2, 0, chrono.EndOfMonthClamp, chrono.LocalDateOf(2021, chrono.March, 30)},
+		{"error when day exists", jan30, 0, 2, 0, chrono.EndOfMonthError, chrono.LocalDateOf(2021, chrono.March, 30)},
+		{"stick", jan31, 0, 1, 0, chrono.EndOfMonthStick, feb28},
+		{"stick from end of february", feb28, 0, 1, 0, chrono.EndOfMonthStick, chrono.LocalDateOf(2021, chrono.March, 31)},
+		{"stick backwards", chrono.LocalDateOf(2021, chrono.April, 30), 0, -1, 0, chrono.EndOfMonthStick, chrono.LocalDateOf(2021, chrono.March, 31)},
+		{"stick not at end", jan30, 0, 2, 0, chrono.EndOfMonthStick, chrono.LocalDateOf(2021, chrono.March, 30)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.date.CanAddDateWith(tt.addYears, tt.addMonths, tt.addDays, tt.eom); !ok {
+				t.Errorf("date = %s, date.CanAddDateWith(%d, %d, %d, %v) = false, want true", tt.date, tt.addYears, tt.addMonths, tt.addDays, tt.eom)
+			}
+
+			if date := tt.date.AddDateWith(tt.addYears, tt.addMonths, tt.addDays, tt.eom); date != tt.expected {
+				t.Errorf("date = %s, date.AddDateWith(%d, %d, %d, %v) = %s, want %s", tt.date, tt.addYears, tt.addMonths, tt.addDays, tt.eom, date, tt.expected)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name      string
+		date      chrono.LocalDate
+		addMonths int
+		eom       chrono.EndOfMonth
+	}{
+		{"day does not exist", jan31, 1, chrono.EndOfMonthError},
+		{"invalid policy", jan31, 1, chrono.EndOfMonth(-1)},
+		{"overflow", chrono.MaxLocalDate(), 1, chrono.EndOfMonthClamp},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.date.CanAddDateWith(0, tt.addMonths, 0, tt.eom); ok {
+				t.Errorf("date = %s, date.CanAddDateWith(0, %d, 0, %v) = true, want false", tt.date, tt.addMonths, tt.eom)
+			}
+
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Error("expecting panic that didn't occur")
+					}
+				}()
+
+				tt.date.AddDateWith(0, tt.addMonths, 0, tt.eom)
+			}()
+		})
+	}
+}
@@ -1,13 +1,12 @@
 package chrono
 
-import (
-	"math/big"
-)
+import "fmt"
 
 // LocalDateTime is a date and time without a time zone or time component.
 // This is a combination of a LocalDate and LocalTime.
+// LocalDateTimes are comparable with ==, and can be used as map keys.
 type LocalDateTime struct {
-	v big.Int
+	v dateTime
 }
 
 // LocalDateTimeOf returns the LocalDateTime that stores the specified year, month, day,
@@ -35,7 +34,7 @@ func OfLocalDateTime(date LocalDate, time LocalTime) LocalDateTime {
 // Compare compares d with d2. If d is before d2, it returns -1;
 // if d is after d2, it returns 1; if they're the same, it returns 0.
 func (d LocalDateTime) Compare(d2 LocalDateTime) int {
-	return d.v.Cmp(&d2.v)
+	return compareDateTimes(d.v, d2.v)
 }
 
 // Split returns separate a LocalDate and LocalTime that together represent d.
@@ -57,7 +56,7 @@ func (d LocalDateTime) UTC() OffsetDateTime {
 // Add returns the datetime d+v.
 // This function panics if the resulting datetime would fall outside of the allowed range.
 func (d LocalDateTime) Add(v Duration) LocalDateTime {
-	out, err := addDurationToBigDate(d.v, v)
+	out, err := addDurationToDateTime(d.v, v)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -66,14 +65,14 @@ func (d LocalDateTime) Add(v Duration) LocalDateTime {
 
 // CanAdd returns false if Add would panic if passed the same arguments.
 func (d LocalDateTime) CanAdd(v Duration) bool {
-	_, err := addDurationToBigDate(d.v, v)
+	_, err := addDurationToDateTime(d.v, v)
 	return err == nil
 }
 
 // AddDate returns the datetime corresponding to adding the given number of years, months, and days to d.
 // This function panic if the resulting datetime would fall outside of the allowed date range.
 func (d LocalDateTime) AddDate(years, months, days int) LocalDateTime {
-	out, err := addDateToBigDate(d.v, years, months, days)
+	out, err := addDateToDateTime(d.v, years, months, days)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -82,17 +81,121 @@ func (d LocalDateTime) AddDate(years, months, days int) LocalDateTime {
 
 // CanAddDate returns false if AddDate would panic if passed the same arguments.
 func (d LocalDateTime) CanAddDate(years, months, days int) bool {
-	_, err := addDateToBigDate(d.v, years, months, days)
+	_, err := addDateToDateTime(d.v, years, months, days)
+	return err == nil
+}
+
+// AddDateWith returns the datetime corresponding to adding the given number of years, months, and days to d,
+// where eom specifies how to resolve a day that does not exist in the month reached by adding the years and months.
+// The days are added after the day has been resolved.
+// This function panics if the resulting datetime would fall outside of the allowed date range,
+// or if the day does not exist and eom is EndOfMonthError.
+func (d LocalDateTime) AddDateWith(years, months, days int, eom EndOfMonth) LocalDateTime {
+	out, err := addDateToDateTimeWith(d.v, years, months, days, eom)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDateTime{v: out}
+}
+
+// CanAddDateWith returns false if AddDateWith would panic if passed the same arguments.
+func (d LocalDateTime) CanAddDateWith(years, months, days int, eom EndOfMonth) bool {
+	_, err := addDateToDateTimeWith(d.v, years, months, days, eom)
+	return err == nil
+}
+
+// AddPeriod returns the datetime corresponding to adding the period p to d.
+// Weeks and fractional components are applied as described by [Period].
+// This function panics if the resulting datetime would fall outside of the allowed range.
+func (d LocalDateTime) AddPeriod(p Period) LocalDateTime {
+	return d.AddPeriodDuration(p, Duration{})
+}
+
+// CanAddPeriod returns false if AddPeriod would panic if passed the same argument.
+func (d LocalDateTime) CanAddPeriod(p Period) bool {
+	return d.CanAddPeriodDuration(p, Duration{})
+}
+
+// AddPeriodDuration returns the datetime corresponding to adding the period p, followed by the duration v, to d.
+// This function panics if the resulting datetime would fall outside of the allowed range.
+func (d LocalDateTime) AddPeriodDuration(p Period, v Duration) LocalDateTime {
+	out, err := addPeriodDurationToDateTime(d.v, p, v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDateTime{v: out}
+}
+
+// CanAddPeriodDuration returns false if AddPeriodDuration would panic if passed the same arguments.
+func (d LocalDateTime) CanAddPeriodDuration(p Period, v Duration) bool {
+	_, err := addPeriodDurationToDateTime(d.v, p, v)
 	return err == nil
 }
 
 // Sub returns the duration d-u.
 func (d LocalDateTime) Sub(u LocalDateTime) Duration {
-	out := new(big.Int).Set(&d.v)
-	out.Sub(out, &u.v)
-	return Duration{v: *out}
+	return subDateTimes(d.v, u.v, 0)
 }
 
+// Until returns the difference between d and end as a period of years, months and days, as by [LocalDate.Until],
+// plus a duration of less than 24 hours. The components of both are negative if end is before d.
+func (d LocalDateTime) Until(end LocalDateTime) (Period, Duration) {
+	p, v, err := periodDurationBetweenDateTimes(d.v, end.v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return p, v
+}
+
+// Truncate returns the result of rounding d down to the start of the unit in which it falls.
+// Units up to and including UnitDay start at multiples of their length since midnight, UnitWeek starts on Monday
+// according to ISO 8601, and UnitMonth, UnitQuarter and UnitYear start on the first day of the month, quarter or year.
+// This function panics if unit is not valid, or if the result would fall outside of the allowed range.
+func (d LocalDateTime) Truncate(unit Unit) LocalDateTime {
+	return d.round(unit, roundDown)
+}
+
+// CanTruncate returns false if Truncate would panic if passed the same argument.
+func (d LocalDateTime) CanTruncate(unit Unit) bool {
+	_, err := roundDateTime(d.v, unit, roundDown)
+	return err == nil
+}
+
+// Round returns the result of rounding d to the nearest start of a unit, as defined by Truncate.
+// Halfway values round up. This function panics if unit is not valid, or if the result would fall outside of the allowed range.
+func (d LocalDateTime) Round(unit Unit) LocalDateTime {
+	return d.round(unit, roundHalfUp)
+}
+
+// CanRound returns false if Round would panic if passed the same argument.
+func (d LocalDateTime) CanRound(unit Unit) bool {
+	_, err := roundDateTime(d.v, unit, roundHalfUp)
+	return err == nil
+}
+
+// Ceil returns the result of rounding d up to the start of a unit, as defined by Truncate.
+// If d is already at the start of a unit, it is returned unchanged.
+// This function panics if unit is not valid, or if the result would fall outside of the allowed range.
+func (d LocalDateTime) Ceil(unit Unit) LocalDateTime {
+	return d.round(unit, roundUp)
+}
+
+// CanCeil returns false if Ceil would panic if passed the same argument.
+func (d LocalDateTime) CanCeil(unit Unit) bool {
+	_, err := roundDateTime(d.v, unit, roundUp)
+	return err == nil
+}
+
+func (d LocalDateTime) round(unit Unit, mode roundMode) LocalDateTime {
+	out, err := roundDateTime(d.v, unit, mode)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalDateTime{v: out}
+}
+
+// String returns a string formatted according to ISO 8601.
+// Years outside of the range 0000 to 9999 are formatted as described for %nY.
 func (d LocalDateTime) String() string {
 	date, time := splitDateAndTime(d.v)
 	hour, min, sec, nsec := fromTime(time)
@@ -103,6 +206,30 @@ func (d LocalDateTime) String() string {
 	return simpleDateStr(year, month, day) + " " + simpleTimeStr(hour, min, sec, nsec, nil)
 }
 
+// GoString returns the Go syntax that represents d, e.g. chrono.LocalDateTimeOf(2006, chrono.April, 9, 12, 30, 0, 0).
+func (d LocalDateTime) GoString() string {
+	date, time := splitDateAndTime(d.v)
+	hour, min, sec, nsec := fromTime(time)
+	year, month, day, err := fromDate(date)
+	if err != nil {
+		panic(err.Error())
+	}
+	return fmt.Sprintf("chrono.LocalDateTimeOf(%d, %s, %d, %d, %d, %d, %d)", year, goSyntaxMonth(month), day, hour, min, sec, nsec)
+}
+
+// Formatter returns a [Formatter] for d, which supports the verbose %+v representation, padding and truncation.
+func (d LocalDateTime) Formatter() Formatter {
+	return Formatter{
+		typ: "LocalDateTime",
+		str: d.String,
+		verbose: func() string {
+			date, time := splitDateAndTime(d.v)
+			return fmt.Sprintf("chrono.LocalDateTime(%s, jdn=%d, time=%d)", d, date+unixEpochJDN, time)
+		},
+		goValue: d.GoString,
+	}
+}
+
 // Format returns a textual representation of the date-time value formatted according to the layout defined by the argument.
 // See the constants section of the documentation to see how to represent the layout format.
 func (d LocalDateTime) Format(layout string) string {
@@ -117,13 +244,31 @@ func (d LocalDateTime) Format(layout string) string {
 // Parse a formatted string and store the value it represents in d.
 // See the constants section of the documentation to see how to represent the layout format.
 func (d *LocalDateTime) Parse(layout, value string) error {
+	_, err := d.ParseWith(layout, value, 0)
+	return err
+}
+
+// ParseWith behaves the same as Parse, except that the behavior of parsing is modified by opts.
+// The number of bytes of value that were consumed is returned, which is less than len(value)
+// only if ParseTrailingText is used.
+func (d *LocalDateTime) ParseWith(layout, value string, opts ParseOption) (int, error) {
 	dv, tv := splitDateAndTime(d.v)
-	if err := parseDateAndTime(layout, value, &dv, &tv, nil); err != nil {
-		return err
+	n, err := parseDateAndTimeWith(layout, value, opts, &dv, &tv, nil)
+	if err != nil {
+		return n, err
 	}
 
 	d.v = makeDateTime(dv, tv)
-	return nil
+	return n, nil
+}
+
+func (d LocalDateTime) get() (dv, tv, ov *int64) {
+	_dv, _tv := splitDateAndTime(d.v)
+	return &_dv, &_tv, nil
+}
+
+func (d *LocalDateTime) set(dv, tv, ov int64) {
+	d.v = makeDateTime(dv, tv)
 }
 
 // MinLocalDateTime returns the earliest supported datetime.
@@ -0,0 +1,218 @@
+package chrono
+
+// LocalDateTimeInterval represents the intervening time between two local date-times, such as 2026-01-01T09:00:00/2026-01-01T17:00:00.
+// It is the equivalent of [Interval] for [LocalDateTime] endpoints.
+type LocalDateTimeInterval struct {
+	s  *LocalDateTime
+	e  *LocalDateTime
+	d  *periodDuration
+	r  int
+	sk IntervalEndpoint
+	ek IntervalEndpoint
+}
+
+// LocalDateTimeIntervalOfStartEnd creates a [LocalDateTimeInterval] from the provided start and end time points.
+func LocalDateTimeIntervalOfStartEnd(start, end LocalDateTime, repetitions int) LocalDateTimeInterval {
+	return LocalDateTimeInterval{s: &start, e: &end, r: repetitions}
+}
+
+// LocalDateTimeIntervalOfStartDuration creates a [LocalDateTimeInterval] from the provided start time point and duration.
+func LocalDateTimeIntervalOfStartDuration(start LocalDateTime, period Period, duration Duration, repetitions int) LocalDateTimeInterval {
+	return LocalDateTimeInterval{s: &start, d: &periodDuration{Period: period, Duration: duration}, r: repetitions}
+}
+
+// LocalDateTimeIntervalOfDurationEnd creates a [LocalDateTimeInterval] from the provided duration and end time point.
+func LocalDateTimeIntervalOfDurationEnd(period Period, duration Duration, end LocalDateTime, repetitions int) LocalDateTimeInterval {
+	return LocalDateTimeInterval{e: &end, d: &periodDuration{Period: period, Duration: duration}, r: repetitions}
+}
+
+// LocalDateTimeIntervalOfStartOpenEnd creates a [LocalDateTimeInterval] from the provided start time point, and an end that is either
+// [EndpointOpen] or [EndpointUnknown]. If any other endpoint is provided, this function panics.
+func LocalDateTimeIntervalOfStartOpenEnd(start LocalDateTime, end IntervalEndpoint, repetitions int) LocalDateTimeInterval {
+	if end != EndpointOpen && end != EndpointUnknown {
+		panic("invalid endpoint")
+	}
+	return LocalDateTimeInterval{s: &start, ek: end, r: repetitions}
+}
+
+// LocalDateTimeIntervalOfOpenStartEnd creates a [LocalDateTimeInterval] from the provided end time point, and a start that is either
+// [EndpointOpen] or [EndpointUnknown]. If any other endpoint is provided, this function panics.
+func LocalDateTimeIntervalOfOpenStartEnd(start IntervalEndpoint, end LocalDateTime, repetitions int) LocalDateTimeInterval {
+	if start != EndpointOpen && start != EndpointUnknown {
+		panic("invalid endpoint")
+	}
+	return LocalDateTimeInterval{e: &end, sk: start, r: repetitions}
+}
+
+// ParseLocalDateTimeInterval parses an ISO 8601 time interval, or a repeating time interval, without offsets.
+// It accepts the same forms as [ParseInterval], including a fraction of a second, omitted seconds and a reduced end,
+// except that <start> and <end> have no UTC offset, such as 2006-01-02T15:04:05.
+func ParseLocalDateTimeInterval(s string) (LocalDateTimeInterval, error) {
+	parts, err := parseIntervalParts(s)
+	if err != nil {
+		return LocalDateTimeInterval{}, err
+	}
+
+	out := LocalDateTimeInterval{d: parts.pd, r: parts.repeat}
+	if parts.sk == EndpointTime {
+		if out.s, err = parseIntervalDateTime(parts.start); err != nil {
+			return LocalDateTimeInterval{}, err
+		}
+	} else {
+		out.sk = parts.sk
+	}
+
+	if parts.ek == EndpointTime {
+		if parts.sk == EndpointTime {
+			parts.end = completeReducedEnd(parts.start, parts.end)
+		}
+
+		if out.e, err = parseIntervalDateTime(parts.end); err != nil {
+			return LocalDateTimeInterval{}, err
+		}
+	} else {
+		out.ek = parts.ek
+	}
+	return out, nil
+}
+
+func parseIntervalDateTime(value string) (*LocalDateTime, error) {
+	var date, time int64
+	if err := parseDateAndTime(intervalDateTimeLayout(value, false), value, &date, &time, nil); err != nil {
+		return nil, err
+	}
+	return &LocalDateTime{v: makeDateTime(date, time)}, nil
+}
+
+// formatIntervalLocalDateTime formats d in the same manner as [LocalDateTime.String], but with the 'T' designator.
+func formatIntervalLocalDateTime(d LocalDateTime) string {
+	date, time := d.Split()
+	return date.String() + "T" + time.String()
+}
+
+// String returns the formatted LocalDateTimeInterval that can be parsed by [ParseLocalDateTimeInterval].
+// The end is never reduced, and fractions of a second are included only if they are not 0.
+func (i LocalDateTimeInterval) String() string {
+	parts := intervalParts{sk: i.sk, ek: i.ek, pd: i.d, repeat: i.Repetitions()}
+	if i.s != nil {
+		parts.start, parts.sk = formatIntervalLocalDateTime(*i.s), EndpointTime
+	}
+
+	if i.e != nil {
+		parts.end, parts.ek = formatIntervalLocalDateTime(*i.e), EndpointTime
+	}
+	return parts.string("/")
+}
+
+// StartEndpoint returns how the start of i is expressed.
+func (i LocalDateTimeInterval) StartEndpoint() IntervalEndpoint {
+	if i.s != nil {
+		return EndpointTime
+	}
+	return i.sk
+}
+
+// EndEndpoint returns how the end of i is expressed.
+func (i LocalDateTimeInterval) EndEndpoint() IntervalEndpoint {
+	if i.e != nil {
+		return EndpointTime
+	}
+	return i.ek
+}
+
+// Start returns the start time point if present, or a calculated time point if possible
+// by subtracting i.Duration() from i.End().
+// If neither are possible (i.e. only a duration is present),
+// [ErrUnsupportedRepresentation] is returned instead. If the start is open or unknown,
+// [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
+func (i LocalDateTimeInterval) Start() (LocalDateTime, error) {
+	switch {
+	case i.s != nil:
+		return *i.s, nil
+	case i.sk != EndpointAbsent:
+		return LocalDateTime{}, endpointErr(i.sk)
+	case i.e != nil:
+		d, err := i.d.Duration.mul(-1)
+		if err != nil {
+			return LocalDateTime{}, err
+		}
+
+		p, err := i.d.Period.mul(-1)
+		if err != nil {
+			return LocalDateTime{}, err
+		}
+
+		v, err := addPeriodDurationToDateTime(i.e.v, p, d)
+		if err != nil {
+			return LocalDateTime{}, err
+		}
+		return LocalDateTime{v: v}, nil
+	default:
+		return LocalDateTime{}, ErrUnsupportedRepresentation
+	}
+}
+
+// End returns the end time point if present, or a calculated time point if possible
+// by adding i.Duration() to i.Start().
+// If neither are possible, (i.e. only a duration is present),
+// then [ErrUnsupportedRepresentation] is returned instead. If the end is open or unknown,
+// [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
+func (i LocalDateTimeInterval) End() (LocalDateTime, error) {
+	switch {
+	case i.e != nil:
+		return *i.e, nil
+	case i.ek != EndpointAbsent:
+		return LocalDateTime{}, endpointErr(i.ek)
+	case i.s != nil:
+		v, err := addPeriodDurationToDateTime(i.s.v, i.d.Period, i.d.Duration)
+		if err != nil {
+			return LocalDateTime{}, err
+		}
+		return LocalDateTime{v: v}, nil
+	default:
+		return LocalDateTime{}, ErrUnsupportedRepresentation
+	}
+}
+
+// Duration returns the [Period] and [Duration] if present, or a calculated [Duration]
+// if possible by substracting i.Start() from i.End(). Note that the latter case,
+// the [Period] returned will always be the zero value.
+// If the start or end is open or unknown, [ErrOpenEndpoint] or [ErrUnknownEndpoint] is returned.
+func (i LocalDateTimeInterval) Duration() (Period, Duration, error) {
+	switch {
+	case i.d != nil:
+		return i.d.Period, i.d.Duration, nil
+	case i.sk != EndpointAbsent:
+		return Period{}, Duration{}, endpointErr(i.sk)
+	case i.ek != EndpointAbsent:
+		return Period{}, Duration{}, endpointErr(i.ek)
+	case i.s != nil && i.e != nil:
+		return Period{}, i.e.Sub(*i.s), nil
+	default:
+		return Period{}, Duration{}, ErrUnsupportedRepresentation
+	}
+}
+
+// Repetitions returns the number of repetitions of a repeating interval.
+// Any negative number, meaning an unbounded number of repitions, is normalized to -1.
+func (i LocalDateTimeInterval) Repetitions() int {
+	if i.r <= -1 {
+		return -1
+	}
+	return i.r
+}
+
+// In returns the [Interval] that represents i at the specified offset.
+func (i LocalDateTimeInterval) In(offset Offset) Interval {
+	out := Interval{d: i.d, r: i.r, sk: i.sk, ek: i.ek}
+	if i.s != nil {
+		s := i.s.In(offset)
+		out.s = &s
+	}
+
+	if i.e != nil {
+		e := i.e.In(offset)
+		out.e = &e
+	}
+	return out
+}
@@ -0,0 +1,158 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestParseLocalDateTimeInterval(t *testing.T) {
+	for _, tt := range []struct {
+		str       string
+		formatted string
+		start     chrono.LocalDateTime
+		end       chrono.LocalDateTime
+		period    chrono.Period
+		duration  chrono.Duration
+	}{
+		{
+			str:       "2026-02-15T09:00:00/2026-02-15T17:30:00",
+			formatted: "2026-02-15T09:00:00/2026-02-15T17:30:00",
+			start:     chrono.LocalDateTimeOf(2026, chrono.February, 15, 9, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(2026, chrono.February, 15, 17, 30, 0, 0),
+			duration:  chrono.DurationOf(8*chrono.Hour + 30*chrono.Minute),
+		},
+		{
+			str:       "2026-02-15T09:00:00/17:30:00",
+			formatted: "2026-02-15T09:00:00/2026-02-15T17:30:00",
+			start:     chrono.LocalDateTimeOf(2026, chrono.February, 15, 9, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(2026, chrono.February, 15, 17, 30, 0, 0),
+			duration:  chrono.DurationOf(8*chrono.Hour + 30*chrono.Minute),
+		},
+		{
+			str:       "2026-02-15T09:00/17:00",
+			formatted: "2026-02-15T09:00:00/2026-02-15T17:00:00",
+			start:     chrono.LocalDateTimeOf(2026, chrono.February, 15, 9, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(2026, chrono.February, 15, 17, 0, 0, 0),
+			duration:  chrono.DurationOf(8 * chrono.Hour),
+		},
+		{
+			str:       "2026-02-15T09:00:00/17:30",
+			formatted: "2026-02-15T09:00:00/2026-02-15T17:30:00",
+			start:     chrono.LocalDateTimeOf(2026, chrono.February, 15, 9, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(2026, chrono.February, 15, 17, 30, 0, 0),
+			duration:  chrono.DurationOf(8*chrono.Hour + 30*chrono.Minute),
+		},
+		{
+			str:       "2026-02-15T09:00/03-01T09:00:30",
+			formatted: "2026-02-15T09:00:00/2026-03-01T09:00:30",
+			start:     chrono.LocalDateTimeOf(2026, chrono.February, 15, 9, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(2026, chrono.March, 1, 9, 0, 30, 0),
+			duration:  chrono.DurationOf(14*24*chrono.Hour + 30*chrono.Second),
+		},
+		{
+			str:       "2026-02-15T09:00:00/16T09:00:00",
+			formatted: "2026-02-15T09:00:00/2026-02-16T09:00:00",
+			start:     chrono.LocalDateTimeOf(2026, chrono.February, 15, 9, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(2026, chrono.February, 16, 9, 0, 0, 0),
+			duration:  chrono.DurationOf(24 * chrono.Hour),
+		},
+		{
+			str:       "2026-01-31T09:00:00/P1MT1H",
+			formatted: "2026-01-31T09:00:00/P1MT1H",
+			start:     chrono.LocalDateTimeOf(2026, chrono.January, 31, 9, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(2026, chrono.March, 3, 10, 0, 0, 0),
+			period:    chrono.Period{Months: 1},
+			duration:  chrono.DurationOf(chrono.Hour),
+		},
+		{
+			str:       "2026-01-01T09:00:00.5/10:00:00",
+			formatted: "2026-01-01T09:00:00.500000000/2026-01-01T10:00:00",
+			start:     chrono.LocalDateTimeOf(2026, chrono.January, 1, 9, 0, 0, 500000000),
+			end:       chrono.LocalDateTimeOf(2026, chrono.January, 1, 10, 0, 0, 0),
+			duration:  chrono.DurationOf(59*chrono.Minute + 59500*chrono.Millisecond),
+		},
+		{
+			str:       "2026-01-01T09:00:00.0000005/2026-01-01T10:00:00,25",
+			formatted: "2026-01-01T09:00:00.000000500/2026-01-01T10:00:00.250000000",
+			start:     chrono.LocalDateTimeOf(2026, chrono.January, 1, 9, 0, 0, 500),
+			end:       chrono.LocalDateTimeOf(2026, chrono.January, 1, 10, 0, 0, 250000000),
+			duration:  chrono.DurationOf(chrono.Hour + 250*chrono.Millisecond - 500*chrono.Nanosecond),
+		},
+		{
+			str:       "-0044-03-15T09:00:00/16T09:00:00",
+			formatted: "-0044-03-15T09:00:00/-0044-03-16T09:00:00",
+			start:     chrono.LocalDateTimeOf(-44, chrono.March, 15, 9, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(-44, chrono.March, 16, 9, 0, 0, 0),
+			duration:  chrono.DurationOf(24 * chrono.Hour),
+		},
+		{
+			str:       "+10000-01-01T09:00:00/PT1H",
+			formatted: "+10000-01-01T09:00:00/P0DT1H",
+			start:     chrono.LocalDateTimeOf(10000, chrono.January, 1, 9, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(10000, chrono.January, 1, 10, 0, 0, 0),
+			duration:  chrono.DurationOf(chrono.Hour),
+		},
+		{
+			str:       "PT1H/2026-01-01T00:00:00",
+			formatted: "P0DT1H/2026-01-01T00:00:00",
+			start:     chrono.LocalDateTimeOf(2025, chrono.December, 31, 23, 0, 0, 0),
+			end:       chrono.LocalDateTimeOf(2026, chrono.January, 1, 0, 0, 0, 0),
+			duration:  chrono.DurationOf(chrono.Hour),
+		},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			i, err := chrono.ParseLocalDateTimeInterval(tt.str)
+			if err != nil {
+				t.Fatalf("failed to parse interval: %v", err)
+			}
+
+			if d, err := i.Start(); err != nil || d.Compare(tt.start) != 0 {
+				t.Errorf("i.Start() = %v, %v, want %v, nil", d, err, tt.start)
+			}
+
+			if d, err := i.End(); err != nil || d.Compare(tt.end) != 0 {
+				t.Errorf("i.End() = %v, %v, want %v, nil", d, err, tt.end)
+			}
+
+			if p, d, err := i.Duration(); err != nil || !p.Equal(tt.period) || d.Compare(tt.duration) != 0 {
+				t.Errorf("i.Duration() = %v, %v, %v, want %v, %v, nil", p, d, err, tt.period, tt.duration)
+			}
+
+			if formatted := i.String(); formatted != tt.formatted {
+				t.Errorf("i.String() = %v, want %v", formatted, tt.formatted)
+			} else if j, err := chrono.ParseLocalDateTimeInterval(formatted); err != nil || j.String() != formatted {
+				t.Errorf("chrono.ParseLocalDateTimeInterval(%q) = %v, %v", formatted, j, err)
+			}
+		})
+	}
+
+	for _, str := range []string{
+		"2026-02-15T09:00:00.1234567890/2026-02-15T17:30:00",
+		"2026-02-15T09:00:00./2026-02-15T17:30:00",
+		"2026-02-15T09:00:00Z/2026-02-15T17:30:00Z",
+		"2026-02-15/2026-02-16",
+		"2026-02-15T09:00:00/5T17:30:00",
+		"2026-02-15T09:00:00/2-15T17:30:00",
+		"2026-02-15T09:30.5/10:00",
+		"2026-02-15T09:00:00",
+	} {
+		t.Run(str, func(t *testing.T) {
+			if _, err := chrono.ParseLocalDateTimeInterval(str); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeInterval_In(t *testing.T) {
+	i, err := chrono.ParseLocalDateTimeInterval("R2/2026-02-15T09:00:00/PT8H")
+	if err != nil {
+		t.Fatalf("failed to parse interval: %v", err)
+	}
+
+	expected := "R2/2026-02-15T09:00:00+01:00/P0DT8H"
+	if formatted := i.In(chrono.OffsetOf(1, 0)).String(); formatted != expected {
+		t.Errorf("i.In().String() = %v, want %v", formatted, expected)
+	}
+}
@@ -185,6 +185,252 @@ func TestLocalDateTime_AddDate(t *testing.T) {
 	}
 }
 
+func TestLocalDateTime_AddDateWith(t *testing.T) {
+	dt := chrono.LocalDateTimeOf(2021, chrono.January, 31, 12, 30, 0, 0)
+	for _, tt := range []struct {
+		eom      chrono.EndOfMonth
+		expected chrono.LocalDateTime
+	}{
+		{chrono.EndOfMonthOverflow, chrono.LocalDateTimeOf(2021, chrono.March, 3, 12, 30, 0, 0)},
+		{chrono.EndOfMonthClamp, chrono.LocalDateTimeOf(2021, chrono.February, 28, 12, 30, 0, 0)},
+		{chrono.EndOfMonthStick, chrono.LocalDateTimeOf(2021, chrono.February, 28, 12, 30, 0, 0)},
+	} {
+		t.Run(tt.eom.String(), func(t *testing.T) {
+			if ok := dt.CanAddDateWith(0, 1, 0, tt.eom); !ok {
+				t.Errorf("datetime = %s, datetime.CanAddDateWith(0, 1, 0, %v) = false, want true", dt, tt.eom)
+			}
+
+			if out := dt.AddDateWith(0, 1, 0, tt.eom); out != tt.expected {
+				t.Errorf("datetime = %s, datetime.AddDateWith(0, 1, 0, %v) = %s, want %s", dt, tt.eom, out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		if ok := dt.CanAddDateWith(0, 1, 0, chrono.EndOfMonthError); ok {
+			t.Errorf("datetime = %s, datetime.CanAddDateWith(0, 1, 0, error) = true, want false", dt)
+		}
+	})
+}
+
+func TestLocalDateTime_AddPeriod(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		datetime chrono.LocalDateTime
+		period   chrono.Period
+		duration chrono.Duration
+		expected chrono.LocalDateTime
+	}{
+		{
+			name:     "years months weeks days",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0),
+			period:   chrono.Period{Years: 1, Months: 2, Weeks: 1, Days: 3},
+			duration: chrono.Duration{},
+			expected: chrono.LocalDateTimeOf(2021, chrono.May, 28, 12, 0, 0, 0),
+		},
+		{
+			name:     "negative",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0),
+			period:   chrono.Period{Months: -1, Days: -1},
+			duration: chrono.DurationOf(-13 * chrono.Hour),
+			expected: chrono.LocalDateTimeOf(2020, chrono.February, 16, 23, 0, 0, 0),
+		},
+		{
+			name:     "fractional month",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0),
+			period:   chrono.Period{YearsFraction: 100000000},
+			duration: chrono.Duration{},
+			expected: chrono.LocalDateTimeOf(2020, chrono.February, 6, 19, 12, 0, 0),
+		},
+		{
+			name:     "fractional week",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0),
+			period:   chrono.Period{Weeks: 1, WeeksFraction: 500000000},
+			duration: chrono.DurationOf(30 * chrono.Minute),
+			expected: chrono.LocalDateTimeOf(2020, chrono.January, 11, 12, 30, 0, 0),
+		},
+		{
+			name:     "negative fractional day",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.January, 10, 6, 0, 0, 0),
+			period:   chrono.Period{DaysFraction: -500000000},
+			duration: chrono.Duration{},
+			expected: chrono.LocalDateTimeOf(2020, chrono.January, 9, 18, 0, 0, 0),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.datetime.CanAddPeriodDuration(tt.period, tt.duration); !ok {
+				t.Errorf("%s.CanAddPeriodDuration(%v, %v) = false, want true", tt.datetime, tt.period, tt.duration)
+			}
+
+			if dt := tt.datetime.AddPeriodDuration(tt.period, tt.duration); dt != tt.expected {
+				t.Errorf("%s.AddPeriodDuration(%v, %v) = %s, want %s", tt.datetime, tt.period, tt.duration, dt, tt.expected)
+			}
+
+			if tt.duration == (chrono.Duration{}) {
+				if dt := tt.datetime.AddPeriod(tt.period); dt != tt.expected {
+					t.Errorf("%s.AddPeriod(%v) = %s, want %s", tt.datetime, tt.period, dt, tt.expected)
+				}
+			}
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		dt := chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0)
+		if ok := dt.CanAddPeriod(chrono.Period{Years: 10000000}); ok {
+			t.Errorf("%s.CanAddPeriod(P10000000Y) = true, want false", dt)
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic that didn't occur")
+				}
+			}()
+
+			dt.AddPeriod(chrono.Period{Years: 10000000})
+		}()
+	})
+}
+
+func TestLocalDateTime_Round(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		datetime chrono.LocalDateTime
+		unit     chrono.Unit
+		truncate chrono.LocalDateTime
+		round    chrono.LocalDateTime
+		ceil     chrono.LocalDateTime
+	}{
+		{
+			name:     "nanosecond",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789),
+			unit:     chrono.UnitNanosecond,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789),
+			round:    chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789),
+			ceil:     chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789),
+		},
+		{
+			name:     "microsecond",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789),
+			unit:     chrono.UnitMicrosecond,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456000),
+			round:    chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123457000),
+			ceil:     chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123457000),
+		},
+		{
+			name:     "minute",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 0),
+			unit:     chrono.UnitMinute,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0),
+			round:    chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 31, 0, 0),
+		},
+		{
+			name:     "hour",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.December, 31, 23, 30, 0, 0),
+			unit:     chrono.UnitHour,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.December, 31, 23, 0, 0, 0),
+			round:    chrono.LocalDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0),
+		},
+		{
+			name:     "day",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0),
+			unit:     chrono.UnitDay,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.March, 18, 0, 0, 0, 0),
+			round:    chrono.LocalDateTimeOf(2020, chrono.March, 19, 0, 0, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2020, chrono.March, 19, 0, 0, 0, 0),
+		},
+		{
+			name:     "week",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.March, 19, 12, 0, 0, 0),
+			unit:     chrono.UnitWeek,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.March, 16, 0, 0, 0, 0),
+			round:    chrono.LocalDateTimeOf(2020, chrono.March, 23, 0, 0, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2020, chrono.March, 23, 0, 0, 0, 0),
+		},
+		{
+			name:     "week across year",
+			datetime: chrono.LocalDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0),
+			unit:     chrono.UnitWeek,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.December, 28, 0, 0, 0, 0),
+			round:    chrono.LocalDateTimeOf(2021, chrono.January, 4, 0, 0, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2021, chrono.January, 4, 0, 0, 0, 0),
+		},
+		{
+			name:     "month",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.February, 15, 12, 0, 0, 0),
+			unit:     chrono.UnitMonth,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.February, 1, 0, 0, 0, 0),
+			round:    chrono.LocalDateTimeOf(2020, chrono.March, 1, 0, 0, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2020, chrono.March, 1, 0, 0, 0, 0),
+		},
+		{
+			name:     "month before half",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.February, 15, 11, 59, 0, 0),
+			unit:     chrono.UnitMonth,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.February, 1, 0, 0, 0, 0),
+			round:    chrono.LocalDateTimeOf(2020, chrono.February, 1, 0, 0, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2020, chrono.March, 1, 0, 0, 0, 0),
+		},
+		{
+			name:     "quarter",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.May, 20, 0, 0, 0, 0),
+			unit:     chrono.UnitQuarter,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.April, 1, 0, 0, 0, 0),
+			round:    chrono.LocalDateTimeOf(2020, chrono.July, 1, 0, 0, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2020, chrono.July, 1, 0, 0, 0, 0),
+		},
+		{
+			name:     "year",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0),
+			unit:     chrono.UnitYear,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0),
+			round:    chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0),
+		},
+		{
+			name:     "start of year",
+			datetime: chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0),
+			unit:     chrono.UnitYear,
+			truncate: chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0),
+			round:    chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0),
+			ceil:     chrono.LocalDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.datetime.Truncate(tt.unit); out != tt.truncate {
+				t.Errorf("%s.Truncate(%v) = %s, want %s", tt.datetime, tt.unit, out, tt.truncate)
+			}
+
+			if out := tt.datetime.Round(tt.unit); out != tt.round {
+				t.Errorf("%s.Round(%v) = %s, want %s", tt.datetime, tt.unit, out, tt.round)
+			}
+
+			if out := tt.datetime.Ceil(tt.unit); out != tt.ceil {
+				t.Errorf("%s.Ceil(%v) = %s, want %s", tt.datetime, tt.unit, out, tt.ceil)
+			}
+		})
+	}
+
+	t.Run("invalid unit", func(t *testing.T) {
+		dt := chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0)
+		if dt.CanTruncate(chrono.Unit(0)) {
+			t.Error("expecting CanTruncate to return false")
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic that didn't occur")
+				}
+			}()
+
+			dt.Round(chrono.Unit(0))
+		}()
+	})
+}
+
 func TestLocalDateTime_Sub(t *testing.T) {
 	for _, tt := range []struct {
 		dt1  chrono.LocalDateTime
@@ -229,3 +475,93 @@ func TestLocalDateTime_UTC(t *testing.T) {
 		t.Errorf("dt.UTC() = %s, want %s", output, expected)
 	}
 }
+
+func TestLocalDateTime_Until(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		start    chrono.LocalDateTime
+		end      chrono.LocalDateTime
+		period   chrono.Period
+		duration chrono.Duration
+	}{
+		{
+			name:     "same time",
+			start:    chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0),
+			end:      chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0),
+			period:   chrono.Period{},
+			duration: chrono.Duration{},
+		},
+		{
+			name:     "time only",
+			start:    chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0),
+			end:      chrono.LocalDateTimeOf(2020, chrono.March, 18, 15, 30, 0, 0),
+			period:   chrono.Period{},
+			duration: chrono.DurationOf(3*chrono.Hour + 30*chrono.Minute),
+		},
+		{
+			name:     "borrow day",
+			start:    chrono.LocalDateTimeOf(2020, chrono.January, 1, 10, 0, 0, 0),
+			end:      chrono.LocalDateTimeOf(2020, chrono.January, 3, 8, 0, 0, 0),
+			period:   chrono.Period{Days: 1},
+			duration: chrono.DurationOf(22 * chrono.Hour),
+		},
+		{
+			name:     "clamped to end of month",
+			start:    chrono.LocalDateTimeOf(2021, chrono.January, 31, 12, 0, 0, 0),
+			end:      chrono.LocalDateTimeOf(2021, chrono.March, 1, 18, 0, 0, 0),
+			period:   chrono.Period{Months: 1, Days: 1},
+			duration: chrono.DurationOf(6 * chrono.Hour),
+		},
+		{
+			name:     "negative",
+			start:    chrono.LocalDateTimeOf(2020, chrono.January, 3, 8, 0, 0, 0),
+			end:      chrono.LocalDateTimeOf(2020, chrono.January, 1, 10, 0, 0, 0),
+			period:   chrono.Period{Days: -1},
+			duration: chrono.DurationOf(-22 * chrono.Hour),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if p, d := tt.start.Until(tt.end); p != tt.period || d.Compare(tt.duration) != 0 {
+				t.Errorf("%s.Until(%s) = %v, %v, want %v, %v", tt.start, tt.end, p, d, tt.period, tt.duration)
+			}
+		})
+	}
+}
+
+func TestLocalDateTime_comparable(t *testing.T) {
+	d := chrono.LocalDateTimeOf(2020, chrono.March, 18, 23, 0, 0, 0)
+	if d.Add(chrono.DurationOf(2*chrono.Hour)) != chrono.LocalDateTimeOf(2020, chrono.March, 19, 1, 0, 0, 0) {
+		t.Error("equal datetimes are not ==")
+	}
+
+	if chrono.OfLocalDateTime(chrono.LocalDateOf(2020, chrono.March, 18), chrono.LocalTimeOf(25, 0, 0, 0)) != chrono.LocalDateTimeOf(2020, chrono.March, 19, 1, 0, 0, 0) {
+		t.Error("equal datetimes are not ==")
+	}
+}
+
+func BenchmarkLocalDateTime_Add(b *testing.B) {
+	d := chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0)
+	v := chrono.DurationOf(36 * chrono.Hour)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.Add(v)
+	}
+}
+
+func BenchmarkLocalDateTime_Sub(b *testing.B) {
+	d := chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0)
+	u := chrono.LocalDateTimeOf(2019, chrono.January, 1, 0, 0, 0, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.Sub(u)
+	}
+}
+
+func BenchmarkLocalDateTime_Compare(b *testing.B) {
+	d := chrono.LocalDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0)
+	u := chrono.LocalDateTimeOf(2019, chrono.January, 1, 0, 0, 0, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.Compare(u)
+	}
+}
@@ -1,5 +1,7 @@
 package chrono
 
+import "fmt"
+
 // LocalTime is a time without a time zone or date component.
 // It represents a time within the 24-hour clock system with nanosecond precision, according to ISO 8601.
 //
@@ -61,6 +63,53 @@ func (t LocalTime) CanAdd(v Extent) bool {
 	return err == nil
 }
 
+// Truncate returns the result of rounding t down to a multiple of unit, which must be between UnitNanosecond and UnitDay,
+// such that truncating to UnitDay gives 00:00. This function panics if unit is not supported.
+func (t LocalTime) Truncate(unit Unit) LocalTime {
+	return t.round(unit, roundDown)
+}
+
+// CanTruncate returns false if Truncate would panic if passed the same argument.
+func (t LocalTime) CanTruncate(unit Unit) bool {
+	_, err := roundTime(t.v, unit, roundDown)
+	return err == nil
+}
+
+// Round returns the result of rounding t to the nearest multiple of unit, which must be between UnitNanosecond and UnitDay.
+// Halfway values round up. A time that is rounded up to midnight at the end of the day wraps around to 00:00,
+// such that 23:45 rounded to the nearest hour is 00:00.
+// This function panics if unit is not supported, or if the result exceeds the maximum representable time.
+func (t LocalTime) Round(unit Unit) LocalTime {
+	return t.round(unit, roundHalfUp)
+}
+
+// CanRound returns false if Round would panic if passed the same argument.
+func (t LocalTime) CanRound(unit Unit) bool {
+	_, err := roundTime(t.v, unit, roundHalfUp)
+	return err == nil
+}
+
+// Ceil returns the result of rounding t up to a multiple of unit, which must be between UnitNanosecond and UnitDay.
+// As for Round, a time that is rounded up to midnight at the end of the day wraps around to 00:00.
+// This function panics if unit is not supported, or if the result exceeds the maximum representable time.
+func (t LocalTime) Ceil(unit Unit) LocalTime {
+	return t.round(unit, roundUp)
+}
+
+// CanCeil returns false if Ceil would panic if passed the same argument.
+func (t LocalTime) CanCeil(unit Unit) bool {
+	_, err := roundTime(t.v, unit, roundUp)
+	return err == nil
+}
+
+func (t LocalTime) round(unit Unit, mode roundMode) LocalTime {
+	out, err := roundTime(t.v, unit, mode)
+	if err != nil {
+		panic(err.Error())
+	}
+	return LocalTime{v: out}
+}
+
 // Compare compares t with t2. If t is before t2, it returns -1;
 // if t is after t2, it returns 1; if they're the same, it returns 0.
 func (t LocalTime) Compare(t2 LocalTime) int {
@@ -72,6 +121,24 @@ func (t LocalTime) String() string {
 	return simpleTimeStr(hour, min, sec, nsec, nil)
 }
 
+// GoString returns the Go syntax that represents t, e.g. chrono.LocalTimeOf(12, 30, 0, 0).
+func (t LocalTime) GoString() string {
+	hour, min, sec, nsec := fromTime(t.v)
+	return fmt.Sprintf("chrono.LocalTimeOf(%d, %d, %d, %d)", hour, min, sec, nsec)
+}
+
+// Formatter returns a [Formatter] for t, which supports the verbose %+v representation, padding and truncation.
+func (t LocalTime) Formatter() Formatter {
+	return Formatter{
+		typ: "LocalTime",
+		str: t.String,
+		verbose: func() string {
+			return fmt.Sprintf("chrono.LocalTime(%s, time=%d)", t, t.v)
+		},
+		goValue: t.GoString,
+	}
+}
+
 // In returns the OffsetTime represeting t with the specified offset.
 func (t LocalTime) In(offset Offset) OffsetTime {
 	return OffsetTime{v: t.v, o: int64(offset)}
@@ -97,11 +164,28 @@ func (t LocalTime) Format(layout string) string {
 // See the constants section of the documentation to see how to represent the layout format.
 // Date format specifiers encountered in the layout results in a panic.
 func (t *LocalTime) Parse(layout, value string) error {
+	_, err := t.ParseWith(layout, value, 0)
+	return err
+}
+
+// ParseWith behaves the same as Parse, except that the behavior of parsing is modified by opts.
+// The number of bytes of value that were consumed is returned, which is less than len(value)
+// only if ParseTrailingText is used.
+func (t *LocalTime) ParseWith(layout, value string, opts ParseOption) (int, error) {
 	v := t.v
-	if err := parseDateAndTime(layout, value, nil, &v, nil); err != nil {
-		return err
+	n, err := parseDateAndTimeWith(layout, value, opts, nil, &v, nil)
+	if err != nil {
+		return n, err
 	}
 
 	t.v = v
-	return nil
+	return n, nil
+}
+
+func (t LocalTime) get() (dv, tv, ov *int64) {
+	return nil, &t.v, nil
+}
+
+func (t *LocalTime) set(dv, tv, ov int64) {
+	t.v = tv
 }
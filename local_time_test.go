@@ -122,6 +122,60 @@ func TestLocalTime_Add(t *testing.T) {
 	}
 }
 
+func TestLocalTime_Round(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		time     chrono.LocalTime
+		unit     chrono.Unit
+		truncate chrono.LocalTime
+		round    chrono.LocalTime
+		ceil     chrono.LocalTime
+	}{
+		{"exact", chrono.LocalTimeOf(12, 30, 0, 0), chrono.UnitMinute, chrono.LocalTimeOf(12, 30, 0, 0), chrono.LocalTimeOf(12, 30, 0, 0), chrono.LocalTimeOf(12, 30, 0, 0)},
+		{"millisecond", chrono.LocalTimeOf(12, 30, 15, 123456789), chrono.UnitMillisecond, chrono.LocalTimeOf(12, 30, 15, 123000000), chrono.LocalTimeOf(12, 30, 15, 123000000), chrono.LocalTimeOf(12, 30, 15, 124000000)},
+		{"second", chrono.LocalTimeOf(12, 30, 15, 500000000), chrono.UnitSecond, chrono.LocalTimeOf(12, 30, 15, 0), chrono.LocalTimeOf(12, 30, 16, 0), chrono.LocalTimeOf(12, 30, 16, 0)},
+		{"minute", chrono.LocalTimeOf(12, 30, 29, 999999999), chrono.UnitMinute, chrono.LocalTimeOf(12, 30, 0, 0), chrono.LocalTimeOf(12, 30, 0, 0), chrono.LocalTimeOf(12, 31, 0, 0)},
+		{"hour", chrono.LocalTimeOf(12, 45, 0, 0), chrono.UnitHour, chrono.LocalTimeOf(12, 0, 0, 0), chrono.LocalTimeOf(13, 0, 0, 0), chrono.LocalTimeOf(13, 0, 0, 0)},
+		{"hour past midnight", chrono.LocalTimeOf(23, 45, 0, 0), chrono.UnitHour, chrono.LocalTimeOf(23, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0)},
+		{"last minute of the day", chrono.LocalTimeOf(23, 59, 0, 0), chrono.UnitHour, chrono.LocalTimeOf(23, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0)},
+		{"day", chrono.LocalTimeOf(9, 30, 0, 0), chrono.UnitDay, chrono.LocalTimeOf(0, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0)},
+		{"day rounded up", chrono.LocalTimeOf(12, 0, 0, 0), chrono.UnitDay, chrono.LocalTimeOf(0, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0)},
+		{"day at midnight", chrono.LocalTimeOf(0, 0, 0, 0), chrono.UnitDay, chrono.LocalTimeOf(0, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0), chrono.LocalTimeOf(0, 0, 0, 0)},
+		{"hour after 24:00", chrono.LocalTimeOf(25, 40, 0, 0), chrono.UnitHour, chrono.LocalTimeOf(25, 0, 0, 0), chrono.LocalTimeOf(26, 0, 0, 0), chrono.LocalTimeOf(26, 0, 0, 0)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.time.Truncate(tt.unit); out.Compare(tt.truncate) != 0 {
+				t.Errorf("%s.Truncate(%v) = %s, want %s", tt.time, tt.unit, out, tt.truncate)
+			}
+
+			if out := tt.time.Round(tt.unit); out.Compare(tt.round) != 0 {
+				t.Errorf("%s.Round(%v) = %s, want %s", tt.time, tt.unit, out, tt.round)
+			}
+
+			if out := tt.time.Ceil(tt.unit); out.Compare(tt.ceil) != 0 {
+				t.Errorf("%s.Ceil(%v) = %s, want %s", tt.time, tt.unit, out, tt.ceil)
+			}
+		})
+	}
+
+	t.Run("unsupported unit", func(t *testing.T) {
+		if chrono.LocalTimeOf(12, 0, 0, 0).CanTruncate(chrono.UnitWeek) {
+			t.Error("expecting CanTruncate to return false")
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		v := chrono.LocalTimeOf(99, 59, 59, 1)
+		if !v.CanTruncate(chrono.UnitSecond) || !v.CanRound(chrono.UnitSecond) {
+			t.Error("expecting CanTruncate and CanRound to return true")
+		}
+
+		if v.CanCeil(chrono.UnitSecond) {
+			t.Error("expecting CanCeil to return false")
+		}
+	})
+}
+
 func TestLocalTime_Compare(t *testing.T) {
 	for _, tt := range []struct {
 		name     string
@@ -1,6 +1,9 @@
 package chrono
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // UTC represents Universal Coordinated Time (UTC).
 const UTC = Offset(0)
@@ -55,3 +58,53 @@ func offsetString(o int64, sep string) string {
 	hours, mins, _, _ := extentUnits(extentAbs(e))
 	return fmt.Sprintf("%s%02d%s%02d", sign, hours, sep, mins)
 }
+
+// OffsetFunc returns the offset that is in effect at the instant represented by t,
+// such as that of a time zone which observes daylight saving time.
+type OffsetFunc func(t OffsetDateTime) Offset
+
+// fixedOffset returns the offsets of a time zone which is always at offset o.
+func fixedOffset(o int64) OffsetFunc {
+	return func(OffsetDateTime) Offset {
+		return Offset(o)
+	}
+}
+
+// ZoneOffsets returns the offsets that are in effect in the time zone with the provided name,
+// which is UTC or a name in the IANA Time Zone Database, such as Europe/Berlin.
+// The time zone database is loaded in the same way as by the standard library's time.LoadLocation.
+func ZoneOffsets(name string) (OffsetFunc, error) {
+	if name == "UTC" {
+		return fixedOffset(0), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "" || name == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	return locationOffsets(loc), nil
+}
+
+// locationOffsets returns the offsets that are in effect in loc.
+func locationOffsets(loc *time.Location) OffsetFunc {
+	return func(t OffsetDateTime) Offset {
+		secs := t.v.date*86400 + (t.v.time-t.o)/oneSecond
+		_, o := time.Unix(secs, 0).In(loc).Zone()
+		return Offset(int64(o) * oneSecond)
+	}
+}
+
+// resolveLocal returns the earliest time at which the local time v occurs according to offsets,
+// or if v is skipped, the time at which it would occur according to the offset in effect beforehand.
+func resolveLocal(v dateTime, offsets OffsetFunc) OffsetDateTime {
+	before := offsets(OffsetDateTime{v: dateTime{date: v.date - 1, time: v.time}})
+	after := offsets(OffsetDateTime{v: dateTime{date: v.date + 1, time: v.time}})
+
+	for _, o := range []Offset{before, after} {
+		if t := (OffsetDateTime{v: v, o: int64(o)}); offsets(t) == o {
+			return t
+		}
+	}
+	t := OffsetDateTime{v: v, o: int64(before)}
+	return t.In(offsets(t))
+}
@@ -1,12 +1,12 @@
 package chrono
 
-import (
-	"math/big"
-)
+import "fmt"
 
 // OffsetDateTime has the same semantics as LocalDateTime, but with the addition of a timezone offset.
+// OffsetDateTimes are comparable with ==, although two values that represent the same local date and time
+// are only equal if they also have the same offset.
 type OffsetDateTime struct {
-	v big.Int
+	v dateTime
 	o int64
 }
 
@@ -50,7 +50,7 @@ func OfLocalDateTimeOffset(date LocalDate, time LocalTime, offset Extent) Offset
 // Compare compares d with d2. If d is before d2, it returns -1;
 // if d is after d2, it returns 1; if they're the same, it returns 0.
 func (d OffsetDateTime) Compare(d2 OffsetDateTime) int {
-	return d.v.Cmp(&d2.v)
+	return compareDateTimes(d.v, d2.v)
 }
 
 // Offset returns the offset of d.
@@ -67,14 +67,14 @@ func (d OffsetDateTime) Split() (LocalDate, OffsetTime) {
 // In returns a copy of t, adjusted to the supplied offset.
 func (d OffsetDateTime) In(offset Offset) OffsetDateTime {
 	return OffsetDateTime{
-		v: bigDateToOffset(d.v, d.o, int64(offset)),
+		v: dateTimeToOffset(d.v, d.o, int64(offset)),
 		o: int64(offset),
 	}
 }
 
 // UTC is a shortcut for t.In(UTC).
 func (d OffsetDateTime) UTC() OffsetDateTime {
-	return OffsetDateTime{v: bigDateToOffset(d.v, d.o, 0)}
+	return OffsetDateTime{v: dateTimeToOffset(d.v, d.o, 0)}
 }
 
 // Local returns the LocalDateTime represented by d.
@@ -85,7 +85,7 @@ func (d OffsetDateTime) Local() LocalDateTime {
 // Add returns the datetime d+v.
 // This function panics if the resulting datetime would fall outside of the allowed range.
 func (d OffsetDateTime) Add(v Duration) OffsetDateTime {
-	out, err := addDurationToBigDate(d.v, v)
+	out, err := addDurationToDateTime(d.v, v)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -94,14 +94,14 @@ func (d OffsetDateTime) Add(v Duration) OffsetDateTime {
 
 // CanAdd returns false if Add would panic if passed the same arguments.
 func (d OffsetDateTime) CanAdd(v Duration) bool {
-	_, err := addDurationToBigDate(d.v, v)
+	_, err := addDurationToDateTime(d.v, v)
 	return err == nil
 }
 
 // AddDate returns the datetime corresponding to adding the given number of years, months, and days to d.
 // This function panic if the resulting datetime would fall outside of the allowed date range.
 func (d OffsetDateTime) AddDate(years, months, days int) OffsetDateTime {
-	out, err := addDateToBigDate(d.v, years, months, days)
+	out, err := addDateToDateTime(d.v, years, months, days)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -110,19 +110,123 @@ func (d OffsetDateTime) AddDate(years, months, days int) OffsetDateTime {
 
 // CanAddDate returns false if AddDate would panic if passed the same arguments.
 func (d OffsetDateTime) CanAddDate(years, months, days int) bool {
-	_, err := addDateToBigDate(d.v, years, months, days)
+	_, err := addDateToDateTime(d.v, years, months, days)
+	return err == nil
+}
+
+// AddDateWith returns the datetime corresponding to adding the given number of years, months, and days to d,
+// where eom specifies how to resolve a day that does not exist in the month reached by adding the years and months.
+// The days are added after the day has been resolved.
+// This function panics if the resulting datetime would fall outside of the allowed date range,
+// or if the day does not exist and eom is EndOfMonthError.
+func (d OffsetDateTime) AddDateWith(years, months, days int, eom EndOfMonth) OffsetDateTime {
+	out, err := addDateToDateTimeWith(d.v, years, months, days, eom)
+	if err != nil {
+		panic(err.Error())
+	}
+	return OffsetDateTime{v: out, o: d.o}
+}
+
+// CanAddDateWith returns false if AddDateWith would panic if passed the same arguments.
+func (d OffsetDateTime) CanAddDateWith(years, months, days int, eom EndOfMonth) bool {
+	_, err := addDateToDateTimeWith(d.v, years, months, days, eom)
+	return err == nil
+}
+
+// AddPeriod returns the datetime corresponding to adding the period p to d.
+// Weeks and fractional components are applied as described by [Period].
+// This function panics if the resulting datetime would fall outside of the allowed range.
+func (d OffsetDateTime) AddPeriod(p Period) OffsetDateTime {
+	return d.AddPeriodDuration(p, Duration{})
+}
+
+// CanAddPeriod returns false if AddPeriod would panic if passed the same argument.
+func (d OffsetDateTime) CanAddPeriod(p Period) bool {
+	return d.CanAddPeriodDuration(p, Duration{})
+}
+
+// AddPeriodDuration returns the datetime corresponding to adding the period p, followed by the duration v, to d.
+// This function panics if the resulting datetime would fall outside of the allowed range.
+func (d OffsetDateTime) AddPeriodDuration(p Period, v Duration) OffsetDateTime {
+	out, err := addPeriodDurationToDateTime(d.v, p, v)
+	if err != nil {
+		panic(err.Error())
+	}
+	return OffsetDateTime{v: out, o: d.o}
+}
+
+// CanAddPeriodDuration returns false if AddPeriodDuration would panic if passed the same arguments.
+func (d OffsetDateTime) CanAddPeriodDuration(p Period, v Duration) bool {
+	_, err := addPeriodDurationToDateTime(d.v, p, v)
 	return err == nil
 }
 
 // Sub returns the duration d-u.
 func (d OffsetDateTime) Sub(u OffsetDateTime) Duration {
-	out := new(big.Int).Set(&d.v)
-	out.Add(out, big.NewInt(d.o))
-	out.Sub(out, &u.v)
-	out.Sub(out, big.NewInt(u.o))
-	return Duration{v: *out}
+	return subDateTimes(d.v, u.v, u.o-d.o)
+}
+
+// Until returns the difference between d and end as a period of years, months and days, as by [LocalDate.Until],
+// plus a duration of less than 24 hours. The components of both are negative if end is before d.
+// The difference is calculated after end has been converted to the offset of d.
+func (d OffsetDateTime) Until(end OffsetDateTime) (Period, Duration) {
+	p, v, err := periodDurationBetweenDateTimes(d.v, dateTimeToOffset(end.v, end.o, d.o))
+	if err != nil {
+		panic(err.Error())
+	}
+	return p, v
+}
+
+// Truncate returns the result of rounding d down to the start of the unit in which it falls.
+// Units up to and including UnitDay start at multiples of their length since midnight, UnitWeek starts on Monday
+// according to ISO 8601, and UnitMonth, UnitQuarter and UnitYear start on the first day of the month, quarter or year.
+// The datetime is rounded in its own offset, such that the start of a day is midnight at that offset.
+// This function panics if unit is not valid, or if the result would fall outside of the allowed range.
+func (d OffsetDateTime) Truncate(unit Unit) OffsetDateTime {
+	return d.round(unit, roundDown)
+}
+
+// CanTruncate returns false if Truncate would panic if passed the same argument.
+func (d OffsetDateTime) CanTruncate(unit Unit) bool {
+	_, err := roundDateTime(d.v, unit, roundDown)
+	return err == nil
+}
+
+// Round returns the result of rounding d to the nearest start of a unit, as defined by Truncate.
+// Halfway values round up. This function panics if unit is not valid, or if the result would fall outside of the allowed range.
+func (d OffsetDateTime) Round(unit Unit) OffsetDateTime {
+	return d.round(unit, roundHalfUp)
+}
+
+// CanRound returns false if Round would panic if passed the same argument.
+func (d OffsetDateTime) CanRound(unit Unit) bool {
+	_, err := roundDateTime(d.v, unit, roundHalfUp)
+	return err == nil
+}
+
+// Ceil returns the result of rounding d up to the start of a unit, as defined by Truncate.
+// If d is already at the start of a unit, it is returned unchanged.
+// This function panics if unit is not valid, or if the result would fall outside of the allowed range.
+func (d OffsetDateTime) Ceil(unit Unit) OffsetDateTime {
+	return d.round(unit, roundUp)
+}
+
+// CanCeil returns false if Ceil would panic if passed the same argument.
+func (d OffsetDateTime) CanCeil(unit Unit) bool {
+	_, err := roundDateTime(d.v, unit, roundUp)
+	return err == nil
+}
+
+func (d OffsetDateTime) round(unit Unit, mode roundMode) OffsetDateTime {
+	out, err := roundDateTime(d.v, unit, mode)
+	if err != nil {
+		panic(err.Error())
+	}
+	return OffsetDateTime{v: out, o: d.o}
 }
 
+// String returns a string formatted according to ISO 8601.
+// Years outside of the range 0000 to 9999 are formatted as described for %nY.
 func (d OffsetDateTime) String() string {
 	date, time := splitDateAndTime(d.v)
 	hour, min, sec, nsec := fromTime(time)
@@ -133,6 +237,30 @@ func (d OffsetDateTime) String() string {
 	return simpleDateStr(year, month, day) + " " + simpleTimeStr(hour, min, sec, nsec, &d.o)
 }
 
+// GoString returns the Go syntax that represents d, e.g. chrono.OffsetDateTimeOf(2006, chrono.April, 9, 12, 30, 0, 0, 2, 0).
+func (d OffsetDateTime) GoString() string {
+	date, time := splitDateAndTime(d.v)
+	hour, min, sec, nsec := fromTime(time)
+	year, month, day, err := fromDate(date)
+	if err != nil {
+		panic(err.Error())
+	}
+	return fmt.Sprintf("chrono.OffsetDateTimeOf(%d, %s, %d, %d, %d, %d, %d, %s)", year, goSyntaxMonth(month), day, hour, min, sec, nsec, goSyntaxOffset(d.o))
+}
+
+// Formatter returns a [Formatter] for d, which supports the verbose %+v representation, padding and truncation.
+func (d OffsetDateTime) Formatter() Formatter {
+	return Formatter{
+		typ: "OffsetDateTime",
+		str: d.String,
+		verbose: func() string {
+			date, time := splitDateAndTime(d.v)
+			return fmt.Sprintf("chrono.OffsetDateTime(%s, jdn=%d, time=%d, offset=%d)", d, date+unixEpochJDN, time, d.o)
+		},
+		goValue: d.GoString,
+	}
+}
+
 // Format returns a textual representation of the date-time value formatted according to the layout defined by the argument.
 // See the constants section of the documentation to see how to represent the layout format.
 func (d OffsetDateTime) Format(layout string) string {
@@ -147,14 +275,23 @@ func (d OffsetDateTime) Format(layout string) string {
 // Parse a formatted string and store the value it represents in d.
 // See the constants section of the documentation to see how to represent the layout format.
 func (d *OffsetDateTime) Parse(layout, value string) error {
+	_, err := d.ParseWith(layout, value, 0)
+	return err
+}
+
+// ParseWith behaves the same as Parse, except that the behavior of parsing is modified by opts.
+// The number of bytes of value that were consumed is returned, which is less than len(value)
+// only if ParseTrailingText is used.
+func (d *OffsetDateTime) ParseWith(layout, value string, opts ParseOption) (int, error) {
 	dv, tv := splitDateAndTime(d.v)
 	var ov int64
-	if err := parseDateAndTime(layout, value, &dv, &tv, &ov); err != nil {
-		return err
+	n, err := parseDateAndTimeWith(layout, value, opts, &dv, &tv, &ov)
+	if err != nil {
+		return n, err
 	}
 
 	d.set(dv, tv, ov)
-	return nil
+	return n, nil
 }
 
 func (d OffsetDateTime) get() (dv, tv, ov *int64) {
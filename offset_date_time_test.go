@@ -202,6 +202,280 @@ func TestOffsetDateTime_AddDate(t *testing.T) {
 	}
 }
 
+func TestOffsetDateTime_AddDateWith(t *testing.T) {
+	dt := chrono.OffsetDateTimeOf(2021, chrono.January, 31, 12, 30, 0, 0, 2, 0)
+	for _, tt := range []struct {
+		eom      chrono.EndOfMonth
+		expected chrono.OffsetDateTime
+	}{
+		{chrono.EndOfMonthOverflow, chrono.OffsetDateTimeOf(2021, chrono.March, 3, 12, 30, 0, 0, 2, 0)},
+		{chrono.EndOfMonthClamp, chrono.OffsetDateTimeOf(2021, chrono.February, 28, 12, 30, 0, 0, 2, 0)},
+		{chrono.EndOfMonthStick, chrono.OffsetDateTimeOf(2021, chrono.February, 28, 12, 30, 0, 0, 2, 0)},
+	} {
+		t.Run(tt.eom.String(), func(t *testing.T) {
+			if ok := dt.CanAddDateWith(0, 1, 0, tt.eom); !ok {
+				t.Errorf("datetime = %s, datetime.CanAddDateWith(0, 1, 0, %v) = false, want true", dt, tt.eom)
+			}
+
+			if out := dt.AddDateWith(0, 1, 0, tt.eom); out != tt.expected {
+				t.Errorf("datetime = %s, datetime.AddDateWith(0, 1, 0, %v) = %s, want %s", dt, tt.eom, out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		if ok := dt.CanAddDateWith(0, 1, 0, chrono.EndOfMonthError); ok {
+			t.Errorf("datetime = %s, datetime.CanAddDateWith(0, 1, 0, error) = true, want false", dt)
+		}
+	})
+}
+
+func TestOffsetDateTime_AddPeriod(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		datetime chrono.OffsetDateTime
+		period   chrono.Period
+		duration chrono.Duration
+		expected chrono.OffsetDateTime
+	}{
+		{
+			name:     "years months weeks days",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 2, 0),
+			period:   chrono.Period{Years: 1, Months: 2, Weeks: 1, Days: 3},
+			duration: chrono.Duration{},
+			expected: chrono.OffsetDateTimeOf(2021, chrono.May, 28, 12, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "negative",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 2, 0),
+			period:   chrono.Period{Months: -1, Days: -1},
+			duration: chrono.DurationOf(-13 * chrono.Hour),
+			expected: chrono.OffsetDateTimeOf(2020, chrono.February, 16, 23, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "fractional month",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			period:   chrono.Period{YearsFraction: 100000000},
+			duration: chrono.Duration{},
+			expected: chrono.OffsetDateTimeOf(2020, chrono.February, 6, 19, 12, 0, 0, 2, 0),
+		},
+		{
+			name:     "fractional week",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			period:   chrono.Period{Weeks: 1, WeeksFraction: 500000000},
+			duration: chrono.DurationOf(30 * chrono.Minute),
+			expected: chrono.OffsetDateTimeOf(2020, chrono.January, 11, 12, 30, 0, 0, 2, 0),
+		},
+		{
+			name:     "negative fractional day",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.January, 10, 6, 0, 0, 0, 2, 0),
+			period:   chrono.Period{DaysFraction: -500000000},
+			duration: chrono.Duration{},
+			expected: chrono.OffsetDateTimeOf(2020, chrono.January, 9, 18, 0, 0, 0, 2, 0),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.datetime.CanAddPeriodDuration(tt.period, tt.duration); !ok {
+				t.Errorf("%s.CanAddPeriodDuration(%v, %v) = false, want true", tt.datetime, tt.period, tt.duration)
+			}
+
+			if dt := tt.datetime.AddPeriodDuration(tt.period, tt.duration); dt != tt.expected {
+				t.Errorf("%s.AddPeriodDuration(%v, %v) = %s, want %s", tt.datetime, tt.period, tt.duration, dt, tt.expected)
+			}
+
+			if tt.duration == (chrono.Duration{}) {
+				if dt := tt.datetime.AddPeriod(tt.period); dt != tt.expected {
+					t.Errorf("%s.AddPeriod(%v) = %s, want %s", tt.datetime, tt.period, dt, tt.expected)
+				}
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		input    string
+		expected chrono.OffsetDateTime
+	}{
+		{"-P1DT1H", chrono.OffsetDateTimeOf(2026, chrono.January, 9, 11, 0, 0, 0, 0, 0)},
+		{"-P1M", chrono.OffsetDateTimeOf(2025, chrono.December, 10, 12, 0, 0, 0, 0, 0)},
+		{"-P1.5D", chrono.OffsetDateTimeOf(2026, chrono.January, 9, 0, 0, 0, 0, 0, 0)},
+		{"+P1DT1H", chrono.OffsetDateTimeOf(2026, chrono.January, 11, 13, 0, 0, 0, 0, 0)},
+	} {
+		t.Run("parsed "+tt.input, func(t *testing.T) {
+			p, d, err := chrono.ParseDuration(tt.input)
+			if err != nil {
+				t.Fatalf("failed to parse duration: %v", err)
+			}
+
+			dt := chrono.OffsetDateTimeOf(2026, chrono.January, 10, 12, 0, 0, 0, 0, 0)
+			if out := dt.AddPeriodDuration(p, d); out != tt.expected {
+				t.Errorf("%s.AddPeriodDuration(%v, %v) = %s, want %s", dt, p, d, out, tt.expected)
+			}
+
+			if d == (chrono.Duration{}) {
+				if out := dt.AddPeriod(p); out != tt.expected {
+					t.Errorf("%s.AddPeriod(%v) = %s, want %s", dt, p, out, tt.expected)
+				}
+			}
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		dt := chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0)
+		if ok := dt.CanAddPeriod(chrono.Period{Years: 10000000}); ok {
+			t.Errorf("%s.CanAddPeriod(P10000000Y) = true, want false", dt)
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic that didn't occur")
+				}
+			}()
+
+			dt.AddPeriod(chrono.Period{Years: 10000000})
+		}()
+	})
+}
+
+func TestOffsetDateTime_Round(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		datetime chrono.OffsetDateTime
+		unit     chrono.Unit
+		truncate chrono.OffsetDateTime
+		round    chrono.OffsetDateTime
+		ceil     chrono.OffsetDateTime
+	}{
+		{
+			name:     "nanosecond",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789, 2, 0),
+			unit:     chrono.UnitNanosecond,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789, 2, 0),
+		},
+		{
+			name:     "microsecond",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456789, 2, 0),
+			unit:     chrono.UnitMicrosecond,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123456000, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123457000, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 123457000, 2, 0),
+		},
+		{
+			name:     "minute",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 15, 0, 2, 0),
+			unit:     chrono.UnitMinute,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 30, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 31, 0, 0, 2, 0),
+		},
+		{
+			name:     "hour",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.December, 31, 23, 30, 0, 0, 2, 0),
+			unit:     chrono.UnitHour,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.December, 31, 23, 0, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "day",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 2, 0),
+			unit:     chrono.UnitDay,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 0, 0, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.March, 19, 0, 0, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2020, chrono.March, 19, 0, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "week",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.March, 19, 12, 0, 0, 0, 2, 0),
+			unit:     chrono.UnitWeek,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.March, 16, 0, 0, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.March, 23, 0, 0, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2020, chrono.March, 23, 0, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "week across year",
+			datetime: chrono.OffsetDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			unit:     chrono.UnitWeek,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.December, 28, 0, 0, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2021, chrono.January, 4, 0, 0, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2021, chrono.January, 4, 0, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "month",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.February, 15, 12, 0, 0, 0, 2, 0),
+			unit:     chrono.UnitMonth,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.February, 1, 0, 0, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.March, 1, 0, 0, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2020, chrono.March, 1, 0, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "month before half",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.February, 15, 11, 59, 0, 0, 2, 0),
+			unit:     chrono.UnitMonth,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.February, 1, 0, 0, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.February, 1, 0, 0, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2020, chrono.March, 1, 0, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "quarter",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.May, 20, 0, 0, 0, 0, 2, 0),
+			unit:     chrono.UnitQuarter,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.April, 1, 0, 0, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.July, 1, 0, 0, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2020, chrono.July, 1, 0, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "year",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 2, 0),
+			unit:     chrono.UnitYear,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2021, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+		},
+		{
+			name:     "start of year",
+			datetime: chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			unit:     chrono.UnitYear,
+			truncate: chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			round:    chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			ceil:     chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.datetime.Truncate(tt.unit); out != tt.truncate {
+				t.Errorf("%s.Truncate(%v) = %s, want %s", tt.datetime, tt.unit, out, tt.truncate)
+			}
+
+			if out := tt.datetime.Round(tt.unit); out != tt.round {
+				t.Errorf("%s.Round(%v) = %s, want %s", tt.datetime, tt.unit, out, tt.round)
+			}
+
+			if out := tt.datetime.Ceil(tt.unit); out != tt.ceil {
+				t.Errorf("%s.Ceil(%v) = %s, want %s", tt.datetime, tt.unit, out, tt.ceil)
+			}
+		})
+	}
+
+	t.Run("invalid unit", func(t *testing.T) {
+		dt := chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 2, 0)
+		if dt.CanTruncate(chrono.Unit(0)) {
+			t.Error("expecting CanTruncate to return false")
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expecting panic that didn't occur")
+				}
+			}()
+
+			dt.Round(chrono.Unit(0))
+		}()
+	})
+}
+
 func TestOffsetDateTime_Sub(t *testing.T) {
 	for _, tt := range []struct {
 		dt1  chrono.OffsetDateTime
@@ -211,7 +485,17 @@ func TestOffsetDateTime_Sub(t *testing.T) {
 		{
 			chrono.OffsetDateTimeOf(2020, chrono.January, 5, 12, 0, 0, 0, 4, 30),
 			chrono.OffsetDateTimeOf(2020, chrono.January, 3, 6, 0, 0, 0, 2, 0),
-			chrono.DurationOf(56*chrono.Hour + 30*chrono.Minute),
+			chrono.DurationOf(51*chrono.Hour + 30*chrono.Minute),
+		},
+		{
+			chrono.OffsetDateTimeOf(2026, chrono.January, 1, 12, 0, 0, 0, 2, 0),
+			chrono.OffsetDateTimeOf(2026, chrono.January, 1, 10, 0, 0, 0, 0, 0),
+			chrono.Duration{},
+		},
+		{
+			chrono.OffsetDateTimeOf(2026, chrono.January, 1, 12, 0, 0, 0, -5, 0),
+			chrono.OffsetDateTimeOf(2026, chrono.January, 1, 12, 0, 0, 0, 1, 0),
+			chrono.DurationOf(6 * chrono.Hour),
 		},
 		{
 			chrono.OffsetDateTimeOf(2020, chrono.January, 5, 12, 0, 0, 22, 0, 0),
@@ -226,3 +510,73 @@ func TestOffsetDateTime_Sub(t *testing.T) {
 		})
 	}
 }
+
+func TestOffsetDateTime_Until(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		start    chrono.OffsetDateTime
+		end      chrono.OffsetDateTime
+		period   chrono.Period
+		duration chrono.Duration
+	}{
+		{
+			name:     "same time",
+			start:    chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 0, 0),
+			end:      chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 0, 0),
+			period:   chrono.Period{},
+			duration: chrono.Duration{},
+		},
+		{
+			name:     "time only",
+			start:    chrono.OffsetDateTimeOf(2020, chrono.March, 18, 12, 0, 0, 0, 0, 0),
+			end:      chrono.OffsetDateTimeOf(2020, chrono.March, 18, 15, 30, 0, 0, 0, 0),
+			period:   chrono.Period{},
+			duration: chrono.DurationOf(3*chrono.Hour + 30*chrono.Minute),
+		},
+		{
+			name:     "borrow day",
+			start:    chrono.OffsetDateTimeOf(2020, chrono.January, 1, 10, 0, 0, 0, 0, 0),
+			end:      chrono.OffsetDateTimeOf(2020, chrono.January, 3, 8, 0, 0, 0, 0, 0),
+			period:   chrono.Period{Days: 1},
+			duration: chrono.DurationOf(22 * chrono.Hour),
+		},
+		{
+			name:     "clamped to end of month",
+			start:    chrono.OffsetDateTimeOf(2021, chrono.January, 31, 12, 0, 0, 0, 0, 0),
+			end:      chrono.OffsetDateTimeOf(2021, chrono.March, 1, 18, 0, 0, 0, 0, 0),
+			period:   chrono.Period{Months: 1, Days: 1},
+			duration: chrono.DurationOf(6 * chrono.Hour),
+		},
+		{
+			name:     "negative",
+			start:    chrono.OffsetDateTimeOf(2020, chrono.January, 3, 8, 0, 0, 0, 0, 0),
+			end:      chrono.OffsetDateTimeOf(2020, chrono.January, 1, 10, 0, 0, 0, 0, 0),
+			period:   chrono.Period{Days: -1},
+			duration: chrono.DurationOf(-22 * chrono.Hour),
+		},
+		{
+			name:     "different offsets",
+			start:    chrono.OffsetDateTimeOf(2020, chrono.January, 1, 0, 0, 0, 0, 2, 0),
+			end:      chrono.OffsetDateTimeOf(2020, chrono.February, 1, 0, 0, 0, 0, 0, 0),
+			period:   chrono.Period{Months: 1},
+			duration: chrono.DurationOf(2 * chrono.Hour),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if p, d := tt.start.Until(tt.end); p != tt.period || d.Compare(tt.duration) != 0 {
+				t.Errorf("%s.Until(%s) = %v, %v, want %v, %v", tt.start, tt.end, p, d, tt.period, tt.duration)
+			}
+		})
+	}
+}
+
+func TestOffsetDateTime_comparable(t *testing.T) {
+	d := chrono.OffsetDateTimeOf(2020, chrono.March, 18, 23, 0, 0, 0, 2, 0)
+	if d.Add(chrono.DurationOf(2*chrono.Hour)) != chrono.OffsetDateTimeOf(2020, chrono.March, 19, 1, 0, 0, 0, 2, 0) {
+		t.Error("equal datetimes are not ==")
+	}
+
+	if d == d.In(chrono.UTC) {
+		t.Error("datetimes with different offsets are ==")
+	}
+}
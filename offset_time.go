@@ -1,5 +1,7 @@
 package chrono
 
+import "fmt"
+
 // OffsetTime has the same semantics as LocalTime, but with the addition of a timezone offset.
 type OffsetTime struct {
 	v, o int64
@@ -84,6 +86,24 @@ func (t OffsetTime) String() string {
 	return simpleTimeStr(hour, min, sec, nsec, &t.o)
 }
 
+// GoString returns the Go syntax that represents t, e.g. chrono.OffsetTimeOf(12, 30, 0, 0, 2, 0).
+func (t OffsetTime) GoString() string {
+	hour, min, sec, nsec := fromTime(t.v)
+	return fmt.Sprintf("chrono.OffsetTimeOf(%d, %d, %d, %d, %s)", hour, min, sec, nsec, goSyntaxOffset(t.o))
+}
+
+// Formatter returns a [Formatter] for t, which supports the verbose %+v representation, padding and truncation.
+func (t OffsetTime) Formatter() Formatter {
+	return Formatter{
+		typ: "OffsetTime",
+		str: t.String,
+		verbose: func() string {
+			return fmt.Sprintf("chrono.OffsetTime(%s, time=%d, offset=%d)", t, t.v, t.o)
+		},
+		goValue: t.GoString,
+	}
+}
+
 // In returns a copy of t, adjusted to the supplied offset.
 func (t OffsetTime) In(offset Offset) OffsetTime {
 	return OffsetTime{
@@ -126,12 +146,30 @@ func (t OffsetTime) Format(layout string) string {
 // See the constants section of the documentation to see how to represent the layout format.
 // Date format specifiers encountered in the layout results in a panic.
 func (t *OffsetTime) Parse(layout, value string) error {
+	_, err := t.ParseWith(layout, value, 0)
+	return err
+}
+
+// ParseWith behaves the same as Parse, except that the behavior of parsing is modified by opts.
+// The number of bytes of value that were consumed is returned, which is less than len(value)
+// only if ParseTrailingText is used.
+func (t *OffsetTime) ParseWith(layout, value string, opts ParseOption) (int, error) {
 	v, o := t.v, t.o
-	if err := parseDateAndTime(layout, value, nil, &v, &o); err != nil {
-		return err
+	n, err := parseDateAndTimeWith(layout, value, opts, nil, &v, &o)
+	if err != nil {
+		return n, err
 	}
 
 	t.v = v
 	t.o = o
-	return nil
+	return n, nil
+}
+
+func (t OffsetTime) get() (dv, tv, ov *int64) {
+	return nil, &t.v, &t.o
+}
+
+func (t *OffsetTime) set(dv, tv, ov int64) {
+	t.v = tv
+	t.o = ov
 }
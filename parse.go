@@ -1,17 +1,23 @@
-//go:build parse
-
 package chrono
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"unicode"
 )
 
+// ParseConfig configures the behavior of Parse and ParseToLayout.
 type ParseConfig struct {
+	// DayFirst causes ambiguous numeric dates that are not valid ISO 8601, such as 09/04/2006,
+	// to be interpreted as day-month-year (9th April 2006) rather than month-day-year (4th September 2006).
+	// A date is only ambiguous if both numbers are valid months, so 25/04/2006 is always the 25th April 2006.
+	// ISO 8601 strings are never ambiguous, and so are not affected.
 	DayFirst bool
 }
 
+// Chronological is implemented by pointers to each of the date and time types of this package:
+// *LocalDate, *LocalTime, *LocalDateTime, *OffsetTime, and *OffsetDateTime.
 type Chronological interface {
 	String() string
 	Parse(layout, value string) error
@@ -19,347 +25,539 @@ type Chronological interface {
 	set(dv, tv, ov int64)
 }
 
-// func Parse(value string, conf ParseConfig) (Chronological, error) {
-// 	// pick type
+// Kind identifies one of the date and time types of this package.
+type Kind int
 
-// 	return nil, nil
-// }
+// Kinds of date and time types.
+const (
+	KindLocalDate Kind = iota + 1
+	KindLocalTime
+	KindLocalDateTime
+	KindOffsetTime
+	KindOffsetDateTime
+)
 
-// ParseToLayout attempts to parse the input string as C and returns
-// the applicable layout string that would parse that string, or format C to that string.
-// Any valid and non-ambiguous ISO 8601 string will be parsed correctly,
-// and any other string will be parsed with a best effort attempt, although the resulting
-// layout string may not be valid.
-func ParseToLayout(value string, conf ParseConfig, c Chronological) (string, error) {
-	var (
-		typ, prevTyp rune // a = alpha, n = numeric, s = separator, w = whitespace, o = other
-		sign         int  // -1 = negative, 0 = no sign, 1 = positive
-		buf          []rune
-
-		state     state
-		layout    []string
-		parts     parts
-		ambiguous []chunk
-	)
+// String returns the name of the type identified by k.
+func (k Kind) String() string {
+	switch k {
+	case KindLocalDate:
+		return "LocalDate"
+	case KindLocalTime:
+		return "LocalTime"
+	case KindLocalDateTime:
+		return "LocalDateTime"
+	case KindOffsetTime:
+		return "OffsetTime"
+	case KindOffsetDateTime:
+		return "OffsetDateTime"
+	default:
+		return "Kind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
 
-	var date, time, offset *int64
-	if c != nil {
-		date, time, offset = c.get()
+func (k Kind) new() Chronological {
+	switch k {
+	case KindLocalDate:
+		return new(LocalDate)
+	case KindLocalTime:
+		return new(LocalTime)
+	case KindLocalDateTime:
+		return new(LocalDateTime)
+	case KindOffsetTime:
+		return new(OffsetTime)
+	case KindOffsetDateTime:
+		return new(OffsetDateTime)
+	default:
+		return nil
 	}
+}
 
-	var err error
-	if date != nil {
-		if parts.year, parts.month, parts.day, err = fromDate(*date); err != nil {
-			return "", err
-		}
+func (k Kind) components() (hasDate, hasTime, hasOffset bool) {
+	return k == KindLocalDate || k == KindLocalDateTime || k == KindOffsetDateTime,
+		k != KindLocalDate,
+		k == KindOffsetTime || k == KindOffsetDateTime
+}
 
-		if parts.isoYear, parts.isoWeek, err = getISOWeek(*date); err != nil {
-			return "", err
-		}
+func kindOf(hasDate, hasTime, hasOffset bool) Kind {
+	switch {
+	case hasDate && hasTime && hasOffset:
+		return KindOffsetDateTime
+	case hasDate && hasTime:
+		return KindLocalDateTime
+	case hasTime && hasOffset:
+		return KindOffsetTime
+	case hasTime:
+		return KindLocalTime
+	case hasDate && !hasOffset:
+		return KindLocalDate
+	default:
+		return 0
 	}
+}
 
-	if time != nil {
-		parts.hour, parts.min, parts.sec, parts.nsec = fromTime(*time)
-		_, parts.isAfternoon = convert24To12HourClock(parts.hour)
+// Parse parses value without a layout, in the same way as ParseToLayout, and returns a pointer to a new value
+// of the type that it represents: one of *LocalDate, *LocalTime, *LocalDateTime, *OffsetTime, or *OffsetDateTime.
+func Parse(value string, conf ParseConfig) (Chronological, error) {
+	layout, kind, err := ParseToLayout(value, conf, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	if offset != nil {
-		parts.offset = *offset
+	c := kind.new()
+	if err := c.Parse(layout, value); err != nil {
+		return nil, err
 	}
+	return c, nil
+}
 
-	_ = sign // TODO
-
-	for i, c := range []rune(value) {
-		switch {
-		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
-			typ = 'a'
-		case c >= '0' && c <= '9':
-			typ = 'n'
-		case (c == '+' || c == '-') && (prevTyp == 0 || prevTyp != 'n'):
-			typ = 'n'
-		case c == '/' || c == '-' || c == '.' || c == ',' || c == ':':
-			typ = 's'
-		case unicode.IsSpace(c):
-			typ = 'w'
-		default:
-			typ = 'o'
-		}
-
-		if typ == prevTyp {
-			buf = append(buf, c)
-
-			if i != len(value)-1 {
-				continue
-			}
+// ParseToLayout determines the layout of value, and the kind of type that it represents.
+// Any valid ISO 8601 calendar date, ordinal date, week date, time, or date-time is recognized,
+// including signed and expanded years, decimal fractions, and UTC offsets.
+// Dates and times are also accepted with a space in place of the 'T' designator, as formatted by the String methods of this package.
+// Any other string is recognized on a best effort basis, with conf used to resolve ambiguities.
+//
+// If c is not nil, value is also parsed and stored in c.
+// An error is returned if c cannot represent all of the components of value.
+func ParseToLayout(value string, conf ParseConfig, c Chronological) (string, Kind, error) {
+	layout, kind, ok := iso8601Layout(value)
+	if !ok {
+		if layout, kind, ok = guessLayout(value, conf); !ok {
+			return "", 0, fmt.Errorf("parsing %q: unrecognized date or time format", value)
 		}
-
-		evalParseRules(prevTyp, buf, conf, &state, &layout, &parts, &ambiguous)
-
-		prevTyp = typ
-		typ = 0
-		sign = 0
-		buf = []rune{c}
 	}
 
-	//fmt.Println("=>", layout)
-
-	evalAmbiguous(ambiguous, conf, layout, &parts)
-
-	layoutStr := strings.Join(layout, "")
-
 	if c != nil {
-		if err := applyParts(parts, date, time, offset); err != nil {
-			return layoutStr, err
+		date, time, offset := c.get()
+		if hasDate, hasTime, hasOffset := kind.components(); hasDate && date == nil || hasTime && time == nil || hasOffset && offset == nil {
+			return layout, kind, fmt.Errorf("parsing %q: cannot store %s in %T", value, kind, c)
+		}
+
+		if err := parseDateAndTime(layout, value, date, time, offset); err != nil {
+			return layout, kind, err
 		}
 
-		c.set(*date, *time, *offset)
+		c.set(derefOrZero(date), derefOrZero(time), derefOrZero(offset))
 	}
+	return layout, kind, nil
+}
 
-	return layoutStr, nil
+func derefOrZero(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
 }
 
-func evalParseRules(prevTyp rune, buf []rune, conf ParseConfig, state *state, layout *[]string, parts *parts, ambiguous *[]chunk) {
-	switch prevTyp {
-	case 'w', 'o':
-		*layout = append(*layout, string(buf))
-	case 's':
-		switch buf[0] {
-		case '-':
-			state.component = componentDate
-		case '/':
-			state.component = componentDate
-			// TODO conf
-		case ':':
-			state.component = componentTime
+// iso8601Layout returns the layout of value and the kind of type that it represents, if value is a valid ISO 8601 string.
+func iso8601Layout(value string) (string, Kind, bool) {
+	s := isoScanner{s: value}
+
+	var hasDate, hasTime, hasOffset bool
+	switch {
+	case s.peek() == 'T':
+		s.emit("T", 1)
+		if hasTime, hasOffset = s.time(); !hasTime {
+			return "", 0, false
 		}
-		*layout = append(*layout, string(buf))
-	case 'n':
-		var sign int
-		switch buf[0] {
-		case '+':
-			sign = 1
-		case '-':
-			sign = -1
+	case s.digits(0) == 2 && s.at(2) == ':':
+		if hasTime, hasOffset = s.time(); !hasTime {
+			return "", 0, false
 		}
-
-		v, err := strconv.Atoi(string(buf))
-		if err != nil {
-			panic(err)
+	default:
+		if hasDate = s.date(); !hasDate {
+			return "", 0, false
 		}
 
-		if str, ok := eval(v, sign, uint(len(buf)), conf, state, parts, false); ok {
-			*layout = append(*layout, str)
-
-			if len(*ambiguous) != 0 {
-				(*ambiguous)[len(*ambiguous)-1].state = *state
+		if sep := s.peek(); (sep == 'T' || sep == ' ') && s.digits(1) != 0 {
+			s.emit(string(sep), 1)
+			if hasTime, hasOffset = s.time(); !hasTime {
+				return "", 0, false
 			}
-
-			return
 		}
+	}
 
-		*layout = append(*layout, string(buf))
-		*ambiguous = append(*ambiguous, chunk{
-			pos:    uint(len(*layout) - 1),
-			v:      v,
-			sign:   sign,
-			digits: uint(len(buf)),
-		})
+	if s.pos != len(s.s) {
+		return "", 0, false
 	}
+	return s.layout, kindOf(hasDate, hasTime, hasOffset), true
 }
 
-func evalAmbiguous(chunks []chunk, conf ParseConfig, layout []string, parts *parts) {
-	for i := len(chunks) - 1; i >= 0; i-- {
-		if str, ok := eval(chunks[i].v, chunks[i].sign, chunks[i].digits, conf, &chunks[i].state, parts, true); ok {
-			layout[chunks[i].pos] = str
-		}
-	}
+type isoScanner struct {
+	s      string
+	pos    int
+	layout string
 }
 
-type state struct {
-	component
-	datePart
-	timePart
+// at returns the byte at offset i from the current position, or 0 if there is none.
+func (s *isoScanner) at(i int) byte {
+	if s.pos+i >= len(s.s) {
+		return 0
+	}
+	return s.s[s.pos+i]
 }
 
-func (s state) String() string {
-	return string(s.component) + string(s.datePart) + string(s.timePart)
+func (s *isoScanner) peek() byte {
+	return s.at(0)
 }
 
-func (s state) d(rev bool) datePart {
-	if rev && s.datePart == datePartYear {
-		return datePartDay
-	} else if rev && s.datePart == datePartDay {
-		return datePartYear
+// digits returns the number of consecutive digits starting at offset i from the current position.
+func (s *isoScanner) digits(i int) int {
+	var n int
+	for c := s.at(i); c >= '0' && c <= '9'; c = s.at(i + n) {
+		n++
 	}
-	return s.datePart
+	return n
 }
 
-func (s state) t(rev bool) timePart {
-	return s.timePart // TODO
+// emit appends layout to the layout string, and advances the current position by n bytes.
+func (s *isoScanner) emit(layout string, n int) {
+	s.layout += layout
+	s.pos += n
 }
 
-type component rune
+func (s *isoScanner) date() bool {
+	var signed int
+	if c := s.peek(); c == '+' || c == '-' {
+		signed = 1
+	}
 
-const (
-	componentNone           = 0
-	componentDate component = 'd'
-	componentTime component = 't'
-)
+	n := s.digits(signed)
+	if n < 4 || (signed == 0 && n != 4 && n != 7 && n != 8) {
+		return false
+	}
 
-type datePart rune
+	year := func(digits int, week bool) (string, bool) {
+		spec := "Y"
+		if week {
+			spec = "G"
+		}
 
-const (
-	datePartNone           = 0
-	datePartYear  datePart = 'y'
-	datePartMonth datePart = 'm'
-	datePartDay   datePart = 'd'
-)
+		switch {
+		case digits == 4:
+			return "%" + spec, true
+		case digits > 4 && digits <= 13 && signed == 1:
+			return "%" + strconv.Itoa(digits-4) + spec, true
+		default:
+			return "", false
+		}
+	}
 
-type timePart rune
+	switch next := s.at(signed + n); {
+	case next == '-': // extended format
+		if s.at(signed+n+1) == 'W' {
+			y, ok := year(n, true)
+			if !ok || s.digits(signed+n+2) != 2 {
+				return false
+			}
+			s.emit(y+"-W%V", signed+n+4)
 
-const (
-	timePartHour   timePart = 'h'
-	timePartMinute timePart = 'm'
-	timePartSecond timePart = 's'
-)
+			if s.peek() == '-' && s.digits(1) == 1 {
+				s.emit("-%u", 2)
+			}
+			return true
+		}
+
+		y, ok := year(n, false)
+		if !ok {
+			return false
+		}
 
-type chunk struct {
-	pos    uint
-	v      int
-	sign   int
-	digits uint
-	state  state
+		switch s.digits(signed + n + 1) {
+		case 2:
+			s.emit(y+"-%m", signed+n+3)
+			if s.peek() == '-' && s.digits(1) == 2 {
+				s.emit("-%d", 3)
+			}
+		case 3:
+			s.emit(y+"-%j", signed+n+4)
+		default:
+			return false
+		}
+		return true
+	case next == 'W': // basic format week date
+		y, ok := year(n, true)
+		if !ok {
+			return false
+		}
+
+		switch s.digits(signed + n + 1) {
+		case 2:
+			s.emit(y+"W%V", signed+n+3)
+		case 3:
+			s.emit(y+"W%V%u", signed+n+4)
+		default:
+			return false
+		}
+		return true
+	default: // basic format, or year only
+		switch {
+		case n >= 8:
+			y, ok := year(n-4, false)
+			if !ok {
+				return false
+			}
+			s.emit(y+"%m%d", signed+n)
+		case n == 7:
+			s.emit("%Y%j", signed+n)
+		default:
+			y, ok := year(n, false)
+			if !ok {
+				return false
+			}
+			s.emit(y, signed+n)
+		}
+		return true
+	}
 }
 
-func eval(v int, sign int, digits uint, conf ParseConfig, state *state, parts *parts, rev bool) (str string, ok bool) {
-	// fmt.Println("========= eval")
-	// fmt.Println("v:", v)
-	// fmt.Println("sign:", sign)
-	// fmt.Println("digits:", digits)
-	// fmt.Println("conf:", conf)
-	// fmt.Println("state:", *state)
-	// fmt.Println("datePart:", string(state.d(rev)))
-	// fmt.Println("parts:", *parts)
-	// fmt.Println("rev:", rev)
-
-	// defer func() {
-	// 	fmt.Println("out:", ok, str)
-	// }()
-
-	switch state.component {
-	case componentNone:
-		switch digits {
-		case 4: // [2006](-01-02) => %Y
-			if sign == 0 {
-				sign = 1
+func (s *isoScanner) time() (ok, hasOffset bool) {
+	var (
+		components int
+		extended   bool
+	)
+
+	switch s.digits(0) {
+	case 2:
+		s.emit("%H", 2)
+		components = 1
+
+		if s.peek() == ':' && s.digits(1) == 2 {
+			s.emit(":%M", 3)
+			components, extended = 2, true
+
+			if s.peek() == ':' && s.digits(1) == 2 {
+				s.emit(":%S", 3)
+				components = 3
 			}
+		}
+	case 4:
+		s.emit("%H%M", 4)
+		components = 2
+	case 6:
+		s.emit("%H%M%S", 6)
+		components = 3
+	default:
+		return false, false
+	}
 
-			state.component = componentDate
-			state.datePart = datePartYear
-			parts.year = v * sign
-			return "%Y", true
+	if mark := s.peek(); isDecimalMark(mark) {
+		n := s.digits(1)
+		if n == 0 {
+			return false, false
 		}
-	case componentDate:
-		switch d := state.d(rev); d {
-		case datePartNone:
-			switch digits {
-			case 2:
-				switch conf.DayFirst {
-				case false:
-					switch sign {
-					case 0: // 01-[02] => %d
-						state.component = componentDate
-						state.datePart = datePartDay
-						parts.day = v
-						return "%d", true
-					}
-				case true:
-					switch sign {
-					case 0: // 02-[01](-2006) => %m
-						state.component = componentDate
-						state.datePart = datePartMonth
-						parts.month = v
-						return "%m", true
-					}
-				}
-			case 4: // 02-[2006] => %Y
-				if sign == 0 {
-					sign = 1
-				}
 
-				state.component = componentDate
-				state.datePart = datePartYear
-				parts.year = v * sign
-				return "%Y", true
+		switch components {
+		case 1:
+			s.layout = strings.TrimSuffix(s.layout, "%H") + "%EH"
+		case 2:
+			s.layout = strings.TrimSuffix(s.layout, "%M") + "%EM"
+		default:
+			precision := n
+			if precision > 9 {
+				precision = 9 // further digits are discarded by %9f
 			}
-		case datePartYear:
-			switch digits {
-			case 2:
-				switch conf.DayFirst {
-				case false:
-					switch sign {
-					case 0: // 2006-[01](-02) => %m
-						state.component = componentDate
-						state.datePart = datePartMonth
-						parts.month = v
-						return "%m", true
-					}
-				case true:
-					switch sign {
-					case 0: // 2006-[02](-01) => %d
-						state.component = componentDate
-						state.datePart = datePartDay
-						parts.day = v
-						return "%d", true
-					}
+			s.layout += string(mark) + "%" + strconv.Itoa(precision) + "f"
+		}
+		s.pos += 1 + n
+	}
+
+	offset := "%z"
+	if extended {
+		offset = "%Ez"
+	}
+
+	switch s.peek() {
+	case 'Z':
+		s.emit(offset, 1)
+	case '+', '-':
+		switch {
+		case s.digits(1) == 2 && s.at(3) == ':' && s.digits(4) == 2:
+			s.emit("%Ez", 6)
+		case s.digits(1) == 4:
+			s.emit("%z", 5)
+		case s.digits(1) == 2:
+			s.emit(offset, 3)
+		default:
+			return false, false
+		}
+	default:
+		return true, false
+	}
+	return true, true
+}
+
+// guessLayout returns the layout of value and the kind of type that it represents,
+// on a best effort basis, for strings that are not valid ISO 8601.
+func guessLayout(value string, conf ParseConfig) (string, Kind, bool) {
+	tokens := tokenize(value)
+	layout := make([]string, len(tokens))
+
+	type number struct {
+		pos, digits, v int
+	}
+
+	var (
+		dates                        []number
+		hasDate, hasTime, hasOffset  bool
+		hour, timeComponents         int
+		monthNamed, twelveHour, prev = false, false, byte(0)
+	)
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		switch {
+		case tok.typ == 'n':
+			switch {
+			case !hasTime && len(tok.s) <= 2 && i+2 < len(tokens) && tokens[i+1].s == ":" && tokens[i+2].typ == 'n':
+				hasTime, hour, timeComponents = true, i, 1
+				layout[i] = "%H"
+			case hasTime && prev == ':' && timeComponents < 3 && len(tok.s) == 2:
+				timeComponents++
+				layout[i] = [...]string{"", "", "%M", "%S"}[timeComponents]
+			case hasTime && timeComponents == 3 && isDecimalMark(prev) && len(tok.s) <= 9:
+				layout[i] = "%" + strconv.Itoa(len(tok.s)) + "f"
+			default:
+				v, err := strconv.Atoi(tok.s)
+				if err != nil {
+					return "", 0, false
 				}
+				dates = append(dates, number{pos: i, digits: len(tok.s), v: v})
 			}
-		case datePartMonth:
-			switch digits {
-			case 2:
-				switch conf.DayFirst {
-				case false:
-					switch sign {
-					case 0: // (2006)-01-[02] => %d
-						state.component = componentDate
-						state.datePart = datePartDay
-						parts.day = v
-						return "%d", true
-					}
-				case true:
-					switch sign {
-					case 0: // // [02]-01(-2006) => %m
-						state.component = componentDate
-						state.datePart = datePartDay
-						parts.day = v
-						return "%d", true
-					}
+		case tok.typ == 'a':
+			lower := strings.ToLower(tok.s)
+			switch {
+			case longMonthNameLookup[lower] != 0:
+				layout[i], hasDate, monthNamed = "%B", true, true
+			case shortMonthNameLookup[lower] != 0:
+				layout[i], hasDate, monthNamed = "%b", true, true
+			case longDayNameLookup[lower] != 0:
+				layout[i], hasDate = "%A", true
+			case shortDayNameLookup[lower] != 0:
+				layout[i], hasDate = "%a", true
+			case hasTime && (lower == "am" || lower == "pm"):
+				layout[i], twelveHour = "%p", true
+				if tok.s == lower {
+					layout[i] = "%P"
 				}
+			case hasTime && tok.s == "Z":
+				layout[i], hasOffset = "%Ez", true
+			default:
+				layout[i] = tok.s
 			}
-		case datePartDay:
-			switch digits {
-			case 2:
-				switch conf.DayFirst {
-				case true:
-					switch sign {
-					case 0: // 2006-02-[01] => %m
-						state.component = componentDate
-						state.datePart = datePartMonth
-						parts.month = v
-						return "%m", true
-					}
-				case false:
-					switch sign {
-					case 0: // [01]-2006 => %m
-						state.component = componentDate
-						state.datePart = datePartMonth
-						parts.month = v
-						return "%m", true
-					}
-				}
+		case hasTime && timeComponents >= 2 && (tok.s == "+" || tok.s == "-") && i+1 < len(tokens) && tokens[i+1].typ == 'n':
+			switch {
+			case len(tokens[i+1].s) == 2 && i+3 < len(tokens) && tokens[i+2].s == ":" && len(tokens[i+3].s) == 2:
+				layout[i], i = "%Ez", i+3
+			case len(tokens[i+1].s) == 2 || len(tokens[i+1].s) == 4:
+				layout[i], i = "%z", i+1
+			default:
+				return "", 0, false
 			}
+			hasOffset = true
+		case tok.s == "%":
+			layout[i] = "%%"
+		default:
+			layout[i] = tok.s
+		}
+
+		if len(tokens[i].s) != 0 {
+			prev = tokens[i].s[len(tokens[i].s)-1]
 		}
 	}
 
-	return "", false
+	if twelveHour {
+		layout[hour] = "%I"
+	}
+
+	year := func(n number) string {
+		if n.digits <= 2 {
+			return "%y"
+		}
+		return "%Y"
+	}
+
+	dayFirst := func(a, b number) bool {
+		switch {
+		case a.v > 12 && b.v <= 12:
+			return true
+		case b.v > 12 && a.v <= 12:
+			return false
+		default:
+			return conf.DayFirst
+		}
+	}
+
+	switch {
+	case len(dates) == 0:
+	case monthNamed && len(dates) <= 2:
+		for i, n := range dates {
+			if n.digits > 2 || i == 1 {
+				layout[n.pos] = year(n)
+			} else {
+				layout[n.pos] = "%d"
+			}
+		}
+	case monthNamed:
+		return "", 0, false
+	case len(dates) == 3 && dates[0].digits > 2:
+		layout[dates[0].pos], layout[dates[1].pos], layout[dates[2].pos] = year(dates[0]), "%m", "%d"
+	case len(dates) == 3:
+		d, m := dates[1], dates[0]
+		if dayFirst(dates[0], dates[1]) {
+			d, m = dates[0], dates[1]
+		}
+		layout[d.pos], layout[m.pos], layout[dates[2].pos] = "%d", "%m", year(dates[2])
+	case len(dates) == 2 && dates[0].digits > 2:
+		layout[dates[0].pos], layout[dates[1].pos] = year(dates[0]), "%m"
+	case len(dates) == 2 && dates[1].digits > 2:
+		layout[dates[0].pos], layout[dates[1].pos] = "%m", year(dates[1])
+	case len(dates) == 2:
+		d, m := dates[1], dates[0]
+		if dayFirst(dates[0], dates[1]) {
+			d, m = dates[0], dates[1]
+		}
+		layout[d.pos], layout[m.pos] = "%d", "%m"
+	default:
+		return "", 0, false
+	}
+
+	if len(dates) != 0 {
+		hasDate = true
+	}
+
+	kind := kindOf(hasDate, hasTime, hasOffset)
+	if kind == 0 {
+		return "", 0, false
+	}
+	return strings.Join(layout, ""), kind, true
+}
+
+type token struct {
+	typ byte // a = alpha, n = numeric, w = whitespace, o = other
+	s   string
+}
+
+// tokenize splits value into runs of letters, digits, and whitespace. Every other character is a token of its own.
+func tokenize(value string) []token {
+	var out []token
+	for _, c := range value {
+		var typ byte
+		switch {
+		case unicode.IsLetter(c):
+			typ = 'a'
+		case c >= '0' && c <= '9':
+			typ = 'n'
+		case unicode.IsSpace(c):
+			typ = 'w'
+		default:
+			typ = 'o'
+		}
+
+		if typ != 'o' && len(out) != 0 && out[len(out)-1].typ == typ {
+			out[len(out)-1].s += string(c)
+			continue
+		}
+		out = append(out, token{typ: typ, s: string(c)})
+	}
+	return out
 }
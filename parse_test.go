@@ -1,8 +1,7 @@
-//go:build parse
-
 package chrono_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/go-chrono/chrono"
@@ -21,13 +20,25 @@ func TestParseToLayout(t *testing.T) {
 			{"%Y-%m-%d", "2006-04-09", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 9, 0, 0, 0, 0, 0, 0), "%Y-%m-%d", nil},
 			{"%Y-%m", "2006-04", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 1, 0, 0, 0, 0, 0, 0), "%Y-%m", nil},
 			{"%Y", "2006", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 1, 1, 0, 0, 0, 0, 0, 0), "%Y", nil},
-			{"%Y-%d", "2006-09", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(2006, 1, 9, 0, 0, 0, 0, 0, 0), "%Y-%d", nil},
+			{"%Y-%m day first", "2006-09", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(2006, 9, 1, 0, 0, 0, 0, 0, 0), "%Y-%m", nil},
 			{"%m-%Y", "04-2006", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 1, 0, 0, 0, 0, 0, 0), "%m-%Y", nil},
 			{"%m-%d", "04-09", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(1970, 4, 9, 0, 0, 0, 0, 0, 0), "%m-%d", nil},
 			{"%d-%m-%Y", "09-04-2006", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(2006, 4, 9, 0, 0, 0, 0, 0, 0), "%d-%m-%Y", nil},
 			{"%d-%m", "09-04", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(1970, 4, 9, 0, 0, 0, 0, 0, 0), "%d-%m", nil},
-			{"%Y-%d-%m", "2006-09-04", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(2006, 4, 9, 0, 0, 0, 0, 0, 0), "%Y-%d-%m", nil},
-			{"%Y-%d", "2006-09", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(2006, 1, 9, 0, 0, 0, 0, 0, 0), "%Y-%d", nil},
+			{"%Y-%m-%d day first", "2006-09-04", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(2006, 9, 4, 0, 0, 0, 0, 0, 0), "%Y-%m-%d", nil},
+			{"%d-%m-%Y unambiguous", "25-04-2006", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 25, 0, 0, 0, 0, 0, 0), "%d-%m-%Y", nil},
+			{"%m/%d/%y", "04/09/06", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 9, 0, 0, 0, 0, 0, 0), "%m/%d/%y", nil},
+			{"%d.%m.%Y", "09.04.2006", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(2006, 4, 9, 0, 0, 0, 0, 0, 0), "%d.%m.%Y", nil},
+			{"%Y/%m/%d", "2006/04/09", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(2006, 4, 9, 0, 0, 0, 0, 0, 0), "%Y/%m/%d", nil},
+			{"%B %d, %Y", "April 9, 2006", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 9, 0, 0, 0, 0, 0, 0), "%B %d, %Y", nil},
+			{"%a, %d %b %Y %H:%M:%S %z", "Sun, 09 Apr 2006 12:30:15 +0200", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 9, 12, 30, 15, 0, 2, 0), "%a, %d %b %Y %H:%M:%S %z", nil},
+			{"%m/%d/%Y %I:%M %p", "04/09/2006 9:30 PM", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 9, 21, 30, 0, 0, 0, 0), "%m/%d/%Y %I:%M %p", nil},
+			{"%Y-%m-%dT%H:%M:%S%Ez", "2006-04-09T12:30:15-07:00", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 9, 12, 30, 15, 0, -7, 0), "%Y-%m-%dT%H:%M:%S%Ez", nil},
+			{"%Y%m%dT%H%M%S%z", "20060409T123015Z", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 9, 12, 30, 15, 0, 0, 0), "%Y%m%dT%H%M%S%z", nil},
+			{"%Y-%m-%d %H:%M:%S.%3f%Ez", "2006-04-09 12:30:15.123+05:30", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 9, 12, 30, 15, 123000000, 5, 30), "%Y-%m-%d %H:%M:%S.%3f%Ez", nil},
+			{"%1Y-%m-%d", "+12006-04-09", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(12006, 4, 9, 0, 0, 0, 0, 0, 0), "%1Y-%m-%d", nil},
+			{"%Y-%j", "-0044-075", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(-44, 3, 15, 0, 0, 0, 0, 0, 0), "%Y-%j", nil},
+			{"unrecognized", "not a date", chrono.ParseConfig{}, chrono.OffsetDateTime{}, "", fmt.Errorf(`parsing "not a date": unrecognized date or time format`)},
 			{"%Y", "2006", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 1, 1, 0, 0, 0, 0, 0, 0), "%Y", nil},
 			{"%Y-%m", "2006-04", chrono.ParseConfig{}, chrono.OffsetDateTimeOf(2006, 4, 1, 0, 0, 0, 0, 0, 0), "%Y-%m", nil},
 			{"%d-%m", "09-04", chrono.ParseConfig{DayFirst: true}, chrono.OffsetDateTimeOf(1970, 4, 9, 0, 0, 0, 0, 0, 0), "%d-%m", nil},
@@ -38,7 +49,7 @@ func TestParseToLayout(t *testing.T) {
 			t.Run(tt.name, func(t *testing.T) {
 				var c chrono.OffsetDateTime
 
-				if actual, err := chrono.ParseToLayout(tt.value, tt.conf, &c); err != nil {
+				if actual, _, err := chrono.ParseToLayout(tt.value, tt.conf, &c); err != nil {
 					if tt.expectedErr == nil {
 						t.Errorf("unexpected error: %v", err)
 					} else if err.Error() != tt.expectedErr.Error() {
@@ -59,3 +70,97 @@ func TestParseToLayout(t *testing.T) {
 		}
 	})
 }
+
+func TestParseToLayout_kinds(t *testing.T) {
+	for _, tt := range []struct {
+		value          string
+		expectedLayout string
+		expectedKind   chrono.Kind
+	}{
+		{"2006-04-09", "%Y-%m-%d", chrono.KindLocalDate},
+		{"20060409", "%Y%m%d", chrono.KindLocalDate},
+		{"2006-099", "%Y-%j", chrono.KindLocalDate},
+		{"2006099", "%Y%j", chrono.KindLocalDate},
+		{"2006-W14-7", "%G-W%V-%u", chrono.KindLocalDate},
+		{"2006-W14", "%G-W%V", chrono.KindLocalDate},
+		{"2006W147", "%GW%V%u", chrono.KindLocalDate},
+		{"+002006-W14-7", "%2G-W%V-%u", chrono.KindLocalDate},
+		{"+0020060409", "%2Y%m%d", chrono.KindLocalDate},
+		{"12:30", "%H:%M", chrono.KindLocalTime},
+		{"12:30:15,5", "%H:%M:%S,%1f", chrono.KindLocalTime},
+		{"T123015", "T%H%M%S", chrono.KindLocalTime},
+		{"T12.5", "T%EH", chrono.KindLocalTime},
+		{"T12:30.25", "T%H:%EM", chrono.KindLocalTime},
+		{"12:30:15Z", "%H:%M:%S%Ez", chrono.KindOffsetTime},
+		{"T1230+02", "T%H%M%z", chrono.KindOffsetTime},
+		{"2006-04-09T12:30:15", "%Y-%m-%dT%H:%M:%S", chrono.KindLocalDateTime},
+		{"2006-04-09 12:30:15.123456789", "%Y-%m-%d %H:%M:%S.%9f", chrono.KindLocalDateTime},
+		{"2006-04-09T12:30:15.1234-07", "%Y-%m-%dT%H:%M:%S.%4f%Ez", chrono.KindOffsetDateTime},
+		{"2006-04-09T12:30:15.1234567891Z", "%Y-%m-%dT%H:%M:%S.%9f%Ez", chrono.KindOffsetDateTime},
+		{"2006-W14-7T12:30Z", "%G-W%V-%uT%H:%M%Ez", chrono.KindOffsetDateTime},
+		{"9:30 pm", "%I:%M %P", chrono.KindLocalTime},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			layout, kind, err := chrono.ParseToLayout(tt.value, chrono.ParseConfig{}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if layout != tt.expectedLayout {
+				t.Errorf("got layout %q, want %q", layout, tt.expectedLayout)
+			}
+
+			if kind != tt.expectedKind {
+				t.Errorf("got kind %s, want %s", kind, tt.expectedKind)
+			}
+
+			c, err := chrono.Parse(tt.value, chrono.ParseConfig{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if typ := fmt.Sprintf("%T", c); typ != "*chrono."+tt.expectedKind.String() {
+				t.Errorf("got type %s, want %s", typ, tt.expectedKind)
+			}
+		})
+	}
+}
+
+func TestParseToLayout_incompatible(t *testing.T) {
+	var d chrono.LocalDate
+	if _, _, err := chrono.ParseToLayout("2006-04-09T12:30:15", chrono.ParseConfig{}, &d); err == nil {
+		t.Error("expecting error but got nil")
+	}
+
+	var tm chrono.LocalTime
+	if _, _, err := chrono.ParseToLayout("12:30:15+02:00", chrono.ParseConfig{}, &tm); err == nil {
+		t.Error("expecting error but got nil")
+	}
+}
+
+func TestParse(t *testing.T) {
+	c, err := chrono.Parse("2006-04-09T12:30:15.5+02:00", chrono.ParseConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d, ok := c.(*chrono.OffsetDateTime)
+	if !ok {
+		t.Fatalf("got %T, want *chrono.OffsetDateTime", c)
+	}
+
+	if expected := chrono.OffsetDateTimeOf(2006, 4, 9, 12, 30, 15, 500000000, 2, 0); d.Compare(expected) != 0 {
+		t.Errorf("got %s, want %s", d, expected)
+	}
+}
+
+func TestParse_fractionBeyondNanoseconds(t *testing.T) {
+	c, err := chrono.Parse("2026-10-17T10:30:15.1234567891Z", chrono.ParseConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expected := chrono.OffsetDateTimeOf(2026, 10, 17, 10, 30, 15, 123456789, 0, 0); *c.(*chrono.OffsetDateTime) != expected {
+		t.Errorf("got %s, want %s", c, expected)
+	}
+}
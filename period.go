@@ -3,94 +3,495 @@ package chrono
 import (
 	"fmt"
 	"math"
+	"math/bits"
 	"strconv"
 	"strings"
 )
 
 // Period represents an amount of time in years, months, weeks and days.
 // A period is not a measurable quantity since the lengths of these components is ambiguous.
+//
+// Each component is stored exactly as a whole number and a decimal fraction, expressed in billionths
+// of the component, such that P2.5Y is represented by Years: 2 and YearsFraction: 500000000.
+// When a period is applied to a date, the fractions are resolved as follows:
+//   - a fraction of a year is converted to months, where a year is 12 months;
+//   - a fraction of a week is converted to days, where a week is 7 days;
+//   - the whole years and months are added first, and any fraction of a month is then converted to days
+//     using the length of the calendar month in which the resulting date falls;
+//   - finally, the whole days are added, and any fraction of a day is added as a fraction of 24 hours.
+//
+// Since a [LocalDate] cannot represent a fraction of a day, any remaining fraction of a day is truncated toward zero.
 type Period struct {
-	Years  float32
-	Months float32
-	Weeks  float32
-	Days   float32
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+
+	YearsFraction  int
+	MonthsFraction int
+	WeeksFraction  int
+	DaysFraction   int
+}
+
+// periodFraction is the number of units of the fractional components of a Period in a whole component.
+const periodFraction = 1000000000
+
+// PeriodBetween returns the period between start and end as a number of years, months and days.
+// It is equivalent to start.Until(end).
+func PeriodBetween(start, end LocalDate) Period {
+	return start.Until(end)
 }
 
 // Equal reports whether p and p2 represent the same period of time.
 func (p Period) Equal(p2 Period) bool {
-	return p2.Years == p.Years && p2.Months == p.Months && p2.Weeks == p.Weeks && p2.Days == p.Days
+	return p2.Years == p.Years && p2.Months == p.Months && p2.Weeks == p.Weeks && p2.Days == p.Days &&
+		p2.YearsFraction == p.YearsFraction && p2.MonthsFraction == p.MonthsFraction &&
+		p2.WeeksFraction == p.WeeksFraction && p2.DaysFraction == p.DaysFraction
+}
+
+// Equivalent reports whether p and p2 are equal once normalized, such that P1Y2M is equivalent to P14M,
+// and P2W3D is equivalent to P17D. Unlike Equal, it does not require each component to be the same.
+func (p Period) Equivalent(p2 Period) bool {
+	n, err := p.normalized()
+	if err != nil {
+		return p == p2
+	}
+
+	n2, err := p2.normalized()
+	if err != nil {
+		return false
+	}
+	return n == n2
+}
+
+// Add returns the period p+p2, where each component is added separately.
+// If a component would overflow or underflow, it panics.
+// Use CanAdd to test whether a panic would occur.
+func (p Period) Add(p2 Period) Period {
+	out, err := p.add(p2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanAdd returns false if Add would panic if passed the same argument.
+func (p Period) CanAdd(p2 Period) bool {
+	_, err := p.add(p2)
+	return err == nil
+}
+
+func (p Period) add(p2 Period) (Period, error) {
+	var out Period
+	for _, c := range []struct {
+		v, frac, v2, frac2 int
+		out, outFrac       *int
+	}{
+		{p.Years, p.YearsFraction, p2.Years, p2.YearsFraction, &out.Years, &out.YearsFraction},
+		{p.Months, p.MonthsFraction, p2.Months, p2.MonthsFraction, &out.Months, &out.MonthsFraction},
+		{p.Weeks, p.WeeksFraction, p2.Weeks, p2.WeeksFraction, &out.Weeks, &out.WeeksFraction},
+		{p.Days, p.DaysFraction, p2.Days, p2.DaysFraction, &out.Days, &out.DaysFraction},
+	} {
+		v, frac, err := addPeriodComponents(int64(c.v), int64(c.frac), int64(c.v2), int64(c.frac2))
+		if err != nil {
+			return Period{}, err
+		}
+		*c.out, *c.outFrac = int(v), int(frac)
+	}
+	return out, nil
+}
+
+// Sub returns the period p-p2, where each component is subtracted separately.
+// If a component would overflow or underflow, it panics.
+// Use CanSub to test whether a panic would occur.
+func (p Period) Sub(p2 Period) Period {
+	out, err := p.sub(p2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanSub returns false if Sub would panic if passed the same argument.
+func (p Period) CanSub(p2 Period) bool {
+	_, err := p.sub(p2)
+	return err == nil
+}
+
+func (p Period) sub(p2 Period) (Period, error) {
+	neg, err := p2.mul(-1)
+	if err != nil {
+		return Period{}, err
+	}
+	return p.add(neg)
+}
+
+// Negate returns the period -p.
+// It panics if a component cannot be negated.
+func (p Period) Negate() Period {
+	out, err := p.mul(-1)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// Multiply returns the period p*n, where each component is multiplied separately.
+// If a component would overflow or underflow, it panics.
+// Use CanMultiply to test whether a panic would occur.
+func (p Period) Multiply(n int) Period {
+	out, err := p.mul(n)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// CanMultiply returns false if Multiply would panic if passed the same argument.
+func (p Period) CanMultiply(n int) bool {
+	_, err := p.mul(n)
+	return err == nil
+}
+
+func (p Period) mul(n int) (Period, error) {
+	var out Period
+	for _, c := range []struct {
+		v, frac      int
+		out, outFrac *int
+	}{
+		{p.Years, p.YearsFraction, &out.Years, &out.YearsFraction},
+		{p.Months, p.MonthsFraction, &out.Months, &out.MonthsFraction},
+		{p.Weeks, p.WeeksFraction, &out.Weeks, &out.WeeksFraction},
+		{p.Days, p.DaysFraction, &out.Days, &out.DaysFraction},
+	} {
+		v, frac, err := mulPeriodComponent(int64(c.v), int64(c.frac), int64(n))
+		if err != nil {
+			return Period{}, err
+		}
+		*c.out, *c.outFrac = int(v), int(frac)
+	}
+	return out, nil
+}
+
+// Normalized returns an equivalent period in which years and months are combined, such that there are fewer than 12 months,
+// and weeks are converted to days at 7 days per week. For example, P14M is normalized to P1Y2M, and P2W3D to P17D.
+// A fraction of a year is converted to months, and the signs of the years and months are the same.
+// It panics if the normalized period cannot be represented.
+func (p Period) Normalized() Period {
+	out, err := p.normalized()
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+func (p Period) normalized() (Period, error) {
+	years, yearsFrac, err := mulPeriodComponent(int64(p.Years), int64(p.YearsFraction), 12)
+	if err != nil {
+		return Period{}, err
+	}
+
+	months, monthsFrac, err := addPeriodComponents(years, yearsFrac, int64(p.Months), int64(p.MonthsFraction))
+	if err != nil {
+		return Period{}, err
+	}
+
+	weeks, weeksFrac, err := mulPeriodComponent(int64(p.Weeks), int64(p.WeeksFraction), 7)
+	if err != nil {
+		return Period{}, err
+	}
+
+	days, daysFrac, err := addPeriodComponents(weeks, weeksFrac, int64(p.Days), int64(p.DaysFraction))
+	if err != nil {
+		return Period{}, err
+	}
+
+	return Period{
+		Years:          int(months / 12),
+		Months:         int(months % 12),
+		Days:           int(days),
+		MonthsFraction: int(monthsFrac),
+		DaysFraction:   int(daysFrac),
+	}, nil
+}
+
+// addPeriodComponents returns the sum of two components, each of which is a whole number v and a fraction in billionths.
+// The result is normalized by normalizePeriodComponent.
+func addPeriodComponents(v1, frac1, v2, frac2 int64) (v, frac int64, err error) {
+	v = v1
+	frac = frac1%periodFraction + frac2%periodFraction
+	for _, w := range []int64{v2, frac1 / periodFraction, frac2 / periodFraction, frac / periodFraction} {
+		var under, over bool
+		if v, under, over = addInt64(v, w); under || over {
+			return 0, 0, fmt.Errorf("period out of range")
+		}
+	}
+
+	v, frac = normalizePeriodComponent(v, frac%periodFraction)
+	return v, frac, nil
+}
+
+// mulPeriodComponent returns the product of n and a component, which is a whole number v and a fraction in billionths.
+// The result is normalized by normalizePeriodComponent.
+func mulPeriodComponent(v, frac, n int64) (int64, int64, error) {
+	v, frac, err := addPeriodComponents(v, frac, 0, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	whole := v * n
+	if (v != 0 && whole/v != n) || (v == -1 && n == math.MinInt64) || (n == -1 && v == math.MinInt64) {
+		return 0, 0, fmt.Errorf("period out of range")
+	}
+
+	// Since |frac| < periodFraction, the product frac*n divided by periodFraction always fits into 64 bits.
+	hi, lo := bits.Mul64(absInt64(frac), absInt64(n))
+	carry, rem := bits.Div64(hi, lo, periodFraction)
+	c, r := int64(carry), int64(rem)
+	if (frac < 0) != (n < 0) {
+		c, r = -c, -r
+	}
+	return addPeriodComponents(whole, r, c, 0)
 }
 
 // String returns a string formatted according to ISO 8601.
 // The output consists of only the period component - the time component is never included.
+// If every component is negative, the output begins with a sign, such as -P1M2D. Since ISO 8601 cannot express
+// a period whose components have mixed signs, each negative component of such a period is instead preceded
+// by its own sign, such as P1M-2D. This form is an extension to ISO 8601, which [Period.Parse] and [ParseDuration]
+// accept but other implementations may reject.
 func (p Period) String() string {
-	if p.Years == 0 && p.Months == 0 && p.Weeks == 0 && p.Days == 0 {
-		return "P0D"
+	if pos, neg := p.signs(); neg && !pos {
+		return "-P" + p.format(false)
 	}
+	return "P" + p.format(true)
+}
 
-	out := "P"
-	if p.Years != 0 {
-		out += strconv.FormatFloat(math.Abs(float64(p.Years)), 'f', -1, 32) + "Y"
+// signs reports whether p has any positive components, and whether it has any negative components.
+func (p Period) signs() (pos, neg bool) {
+	for _, c := range p.components() {
+		pos, neg = pos || c.whole > 0 || c.frac > 0, neg || c.whole < 0 || c.frac < 0
 	}
+	return pos, neg
+}
+
+type periodComponent struct {
+	whole, frac int64
+	designator  byte
+}
 
-	if p.Months != 0 {
-		out += strconv.FormatFloat(math.Abs(float64(p.Months)), 'f', -1, 32) + "M"
+// components returns the normalized components of p.
+func (p Period) components() []periodComponent {
+	out := make([]periodComponent, 0, 4)
+	for _, c := range []struct {
+		v, frac    int
+		designator byte
+	}{
+		{p.Years, p.YearsFraction, 'Y'},
+		{p.Months, p.MonthsFraction, 'M'},
+		{p.Weeks, p.WeeksFraction, 'W'},
+		{p.Days, p.DaysFraction, 'D'},
+	} {
+		whole, frac := normalizePeriodComponent(int64(c.v), int64(c.frac))
+		out = append(out, periodComponent{whole: whole, frac: frac, designator: c.designator})
 	}
+	return out
+}
 
-	if p.Weeks != 0 {
-		out += strconv.FormatFloat(math.Abs(float64(p.Weeks)), 'f', -1, 32) + "W"
+// format formats the components of p without the leading P. If signed is true, each negative component
+// is preceded by a sign, and otherwise only the magnitudes of the components are formatted.
+func (p Period) format(signed bool) string {
+	var out string
+	for _, c := range p.components() {
+		if c.whole == 0 && c.frac == 0 {
+			continue
+		} else if signed && (c.whole < 0 || c.frac < 0) {
+			out += "-"
+		}
+
+		out += strconv.FormatUint(absInt64(c.whole), 10)
+		if c.frac != 0 {
+			out += "." + strings.TrimRight(fmt.Sprintf("%09d", absInt64(c.frac)), "0")
+		}
+		out += string(c.designator)
 	}
 
-	if p.Days != 0 {
-		out += strconv.FormatFloat(math.Abs(float64(p.Days)), 'f', -1, 32) + "D"
+	if out == "" {
+		return "0D"
 	}
 	return out
 }
 
+// normalizePeriodComponent carries any whole units out of frac, such that the returned fraction
+// has the same sign as the returned whole number, and its magnitude is less than periodFraction.
+func normalizePeriodComponent(v, frac int64) (int64, int64) {
+	v += frac / periodFraction
+	frac %= periodFraction
+
+	switch {
+	case v > 0 && frac < 0:
+		v--
+		frac += periodFraction
+	case v < 0 && frac > 0:
+		v++
+		frac -= periodFraction
+	}
+	return v, frac
+}
+
 // Parse the period portion of an ISO 8601 duration.
 // This function supports the ISO 8601-2 extension, which allows weeks (W) to appear in combination
 // with years, months, and days, such as P3W1D. Additionally, it allows a sign character to appear
-// at the start of string, such as +P1M, or -P1M.
+// at the start of string, such as +P1M, or -P1M, in which case the sign applies to every component.
 func (p *Period) Parse(s string) error {
-	years, months, weeks, days, _, _, _, err := parseDuration(s, true, false)
+	period, _, _, _, err := parseDuration(s, true, false)
 	if err != nil {
 		return err
 	}
 
-	p.Years = years
-	p.Months = months
-	p.Weeks = weeks
-	p.Days = days
+	*p = period
 	return nil
 }
 
 // FormatDuration formats a combined period and duration to a complete ISO 8601 duration.
+// If every non-zero component of p and d is negative, the output begins with a sign, such as -P1DT2H.
+// Otherwise, each negative component is preceded by its own sign, as described by [Period.String],
+// such as P1DT-2H-30M, which is accepted by [ParseDuration].
 func FormatDuration(p Period, d Duration, exclusive ...Designator) string {
-	out := p.String()
+	t, dneg := d.format(exclusive...)
+	dpos := !dneg && d != (Duration{})
 
-	t, neg := d.format(exclusive...)
-	out += t
+	switch pos, neg := p.signs(); {
+	case !pos && !dpos && (neg || dneg):
+		return "-P" + p.format(false) + t
+	case !neg && !dneg:
+		return "P" + p.format(false) + t
+	case dneg:
+		return "P" + p.format(true) + signTimeComponents(t)
+	default:
+		return "P" + p.format(true) + t
+	}
+}
 
-	if neg {
-		out = "-" + out
+// signTimeComponents precedes each component of the formatted time component t with a negative sign.
+func signTimeComponents(t string) string {
+	var out strings.Builder
+	for i := 0; i < len(t); i++ {
+		if i > 0 && t[i] >= '0' && t[i] <= '9' && t[i-1] >= 'A' && t[i-1] <= 'Z' {
+			out.WriteByte('-')
+		}
+		out.WriteByte(t[i])
 	}
-	return out
+	return out.String()
 }
 
 // ParseDuration parses a complete ISO 8601 duration.
+// A sign character at the start of the string applies to both the period and the duration.
 func ParseDuration(s string) (Period, Duration, error) {
-	years, months, weeks, days, secs, nsec, neg, err := parseDuration(s, true, true)
-	return Period{
-		Years:  years,
-		Months: months,
-		Weeks:  weeks,
-		Days:   days,
-	}, makeDuration(secs, nsec, neg), err
+	p, secs, nsec, neg, err := parseDuration(s, true, true)
+	if err != nil {
+		return Period{}, Duration{}, err
+	}
+
+	d, err := makeDuration(secs, nsec, neg)
+	if err != nil {
+		return Period{}, Duration{}, err
+	}
+	return p, d, nil
+}
+
+// maxPeriodComponent is larger than any number of days or months that can be added to a date within the supported range,
+// and fits in an int on every platform.
+const maxPeriodComponent int64 = math.MaxInt32
+
+// split returns the whole number of months and days represented by p,
+// along with any remaining fraction of a month and of a day in billionths.
+func (p Period) split() (months, monthFrac, days, dayFrac int64, err error) {
+	n, err := p.normalized()
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	if int64(n.Years) > maxPeriodComponent/12 || int64(n.Years) < -maxPeriodComponent/12 || int64(n.Days) > maxPeriodComponent || int64(n.Days) < -maxPeriodComponent {
+		return 0, 0, 0, 0, fmt.Errorf("period out of range")
+	}
+
+	months = int64(n.Years)*12 + int64(n.Months)
+	return months, int64(n.MonthsFraction), int64(n.Days), int64(n.DaysFraction), nil
+}
+
+// addPeriodToDate adds p to the date d, and returns the resulting date
+// and any remaining fraction of a day in billionths.
+func addPeriodToDate(d int64, p Period) (date, dayFrac int64, err error) {
+	months, monthFrac, days, dayFrac, err := p.split()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if date, err = addDateToDate(d, 0, int(months), 0); err != nil {
+		return 0, 0, err
+	}
+
+	if monthFrac != 0 {
+		year, month, _, err := fromDate(date)
+		if err != nil {
+			return 0, 0, err
+		}
+		days, dayFrac = normalizePeriodComponent(days, dayFrac+monthFrac*int64(getDaysInMonth(year, month)))
+	}
+
+	if date += days; date < minJDN || date > maxJDN {
+		return 0, 0, fmt.Errorf("date out of bounds")
+	}
+	return date, dayFrac, nil
+}
+
+func addPeriodToDateTime(d dateTime, p Period) (dateTime, error) {
+	date, dayFrac, err := addPeriodToDate(d.date, p)
+	if err != nil {
+		return dateTime{}, err
+	}
+
+	out := makeDateTime(date, d.time+dayFrac*(oneDay/periodFraction))
+	if out.date < minJDN || out.date > maxJDN {
+		return dateTime{}, fmt.Errorf("date out of bounds")
+	}
+	return out, nil
+}
+
+func addPeriodDurationToDateTime(d dateTime, p Period, v Duration) (dateTime, error) {
+	out, err := addPeriodToDateTime(d, p)
+	if err != nil {
+		return dateTime{}, err
+	}
+	return addDurationToDateTime(out, v)
+}
+
+// addPeriodDurationToDate adds p, followed by v, to the date d. The sum of v and any fraction of a day
+// remaining from p is truncated toward zero to a whole number of days.
+func addPeriodDurationToDate(d int64, p Period, v Duration) (int64, error) {
+	date, dayFrac, err := addPeriodToDate(d, p)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := durationOf(dayFrac * (oneDay / periodFraction)).add(v)
+	if err != nil {
+		return 0, err
+	}
+
+	days, _, err := total.div(durationOf(oneDay))
+	if err != nil {
+		return 0, err
+	} else if days < minJDN-date || days > maxJDN-date {
+		return 0, fmt.Errorf("date out of bounds")
+	}
+	return date + days, nil
 }
 
-func parseDuration(s string, parsePeriod, parseTime bool) (years, months, weeks, days float32, secs int64, nsec uint32, neg bool, err error) {
+func parseDuration(s string, parsePeriod, parseTime bool) (p Period, secs int64, nsec uint32, neg bool, err error) {
 	if len(s) == 0 {
-		return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("empty string")
+		return Period{}, 0, 0, false, fmt.Errorf("empty string")
 	}
 
 	offset := 1
@@ -100,12 +501,14 @@ func parseDuration(s string, parsePeriod, parseTime bool) (years, months, weeks,
 		neg = true
 		offset++
 	} else if s[0] != 'P' {
-		return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("expecting 'P'")
+		return Period{}, 0, 0, false, fmt.Errorf("expecting 'P'")
 	}
 
 	var value int
 	var onTime bool
 	var haveUnit bool
+	var compNeg bool // whether the current component has its own sign, which is only permitted in periods without a leading sign
+	var total Duration
 
 	for i := offset; i < len(s); i++ {
 		digit := (s[i] >= '0' && s[i] <= '9') || s[i] == '.' || s[i] == ','
@@ -113,53 +516,58 @@ func parseDuration(s string, parsePeriod, parseTime bool) (years, months, weeks,
 		if value == 0 {
 			if digit {
 				value = i
-			} else if s[i] == 'T' {
+			} else if s[i] == '-' && parsePeriod && offset == 1 && !compNeg && i+1 < len(s) {
+				compNeg = true
+			} else if s[i] == 'T' && !compNeg {
 				if !onTime {
 					onTime = true
 				} else {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("unexpected '%c', expecting digit", s[i])
+					return Period{}, 0, 0, false, fmt.Errorf("unexpected '%c', expecting digit", s[i])
 				}
 			} else {
-				return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("unexpected '%c', expecting digit or 'T'", s[i])
+				return Period{}, 0, 0, false, fmt.Errorf("unexpected '%c', expecting digit or 'T'", s[i])
 			}
 		} else {
 			if !onTime {
 				if !parsePeriod {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("cannot parse duration as Duration")
+					return Period{}, 0, 0, false, fmt.Errorf("cannot parse duration as Duration")
 				} else if digit {
 					continue
 				}
 
-				v, err := parseFloat(s[value:i], 32)
+				v, frac, err := parsePeriodComponent(s[value:i])
 				if err != nil {
-					return 0, 0, 0, 0, 0, 0, false, err
+					return Period{}, 0, 0, false, err
+				} else if compNeg {
+					v, frac = -v, -frac
 				}
 
 				switch s[i] {
 				case 'Y':
-					years = float32(v)
+					p.Years, p.YearsFraction = v, frac
 				case 'M':
-					months = float32(v)
+					p.Months, p.MonthsFraction = v, frac
 				case 'W':
-					weeks = float32(v)
+					p.Weeks, p.WeeksFraction = v, frac
 				case 'D':
-					days = float32(v)
+					p.Days, p.DaysFraction = v, frac
 				default:
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("unexpected '%c', expecting 'Y', 'M', 'W', or 'D'", s[i])
+					return Period{}, 0, 0, false, fmt.Errorf("unexpected '%c', expecting 'Y', 'M', 'W', or 'D'", s[i])
 				}
 
 				value = 0
+				compNeg = false
 				haveUnit = true
 			} else {
 				if !parseTime {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("cannot parse duration as Period")
+					return Period{}, 0, 0, false, fmt.Errorf("cannot parse duration as Period")
 				} else if digit {
 					continue
 				}
 
 				v, err := parseFloat(s[value:i], 64)
 				if err != nil {
-					return 0, 0, 0, 0, 0, 0, false, err
+					return Period{}, 0, 0, false, err
 				}
 
 				var _secs float64
@@ -175,39 +583,64 @@ func parseDuration(s string, parsePeriod, parseTime bool) (years, months, weeks,
 					_secs = math.Floor(v)
 					_nsec = uint32((v * 1e9) - (_secs * 1e9))
 				default:
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("unexpected '%c', expecting 'H', 'M' or 'S'", s[i])
-				}
-
-				if _secs < math.MinInt64 {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds underflow")
-				} else if _secs > math.MaxInt64 {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds overflow")
+					return Period{}, 0, 0, false, fmt.Errorf("unexpected '%c', expecting 'H', 'M' or 'S'", s[i])
 				}
 
-				var under, over bool
-				if secs, under, over = addInt64(secs, int64(_secs)); under {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds underflow")
-				} else if over {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds overflow")
+				if _secs > math.MaxInt64 {
+					return Period{}, 0, 0, false, fmt.Errorf("seconds overflow")
 				}
 
-				if secs, under, over = addInt64(secs, int64(_nsec/1e9)); under {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds underflow")
-				} else if over {
-					return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("seconds overflow")
+				c, err := durationFromAbs(compNeg, uint64(_secs)+uint64(_nsec/1e9), _nsec%1e9)
+				if err != nil {
+					return Period{}, 0, 0, false, err
+				} else if total, err = total.add(c); err != nil {
+					return Period{}, 0, 0, false, err
 				}
-				nsec = _nsec % 1e9
 
 				value = 0
+				compNeg = false
 				haveUnit = true
 			}
 		}
 	}
 
-	if !haveUnit {
-		return 0, 0, 0, 0, 0, 0, false, fmt.Errorf("expecting at least one unit")
+	if !haveUnit || compNeg {
+		return Period{}, 0, 0, false, fmt.Errorf("expecting at least one unit")
+	}
+
+	if neg {
+		if p, err = p.mul(-1); err != nil {
+			return Period{}, 0, 0, false, err
+		}
+	}
+
+	tneg, tsecs, tnsec := total.abs()
+	if tsecs > math.MaxInt64 {
+		return Period{}, 0, 0, false, fmt.Errorf("seconds overflow")
+	}
+	return p, int64(tsecs), tnsec, neg != tneg, nil
+}
+
+// parsePeriodComponent parses a decimal number into a whole number and a fraction in billionths.
+// Digits beyond the ninth decimal place are truncated.
+func parsePeriodComponent(s string) (v, frac int, err error) {
+	whole := s
+	var digits string
+	if i := strings.IndexAny(s, ".,"); i >= 0 {
+		whole, digits = s[:i], s[i+1:]
+		if strings.ContainsAny(digits, ".,") {
+			return 0, 0, fmt.Errorf("invalid number %q", s)
+		}
+	}
+
+	if whole == "" && digits == "" {
+		return 0, 0, fmt.Errorf("invalid number %q", s)
+	} else if whole != "" {
+		if v, err = strconv.Atoi(whole); err != nil {
+			return 0, 0, fmt.Errorf("invalid number %q", s)
+		}
 	}
-	return
+	return v, int(parseDecimalFraction(digits, periodFraction)), nil
 }
 
 func parseFloat(s string, bitSize int) (float64, error) {
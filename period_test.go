@@ -7,6 +7,12 @@ import (
 	"github.com/go-chrono/chrono"
 )
 
+// maxInt and minInt are the limits of an int on the platform under test.
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
 func TestPeriod_Format(t *testing.T) {
 	for _, tt := range []struct {
 		name     string
@@ -88,6 +94,31 @@ func TestPeriod_Format(t *testing.T) {
 			input:    chrono.Period{},
 			expected: "P0D",
 		},
+		{
+			name:     "fractional",
+			input:    chrono.Period{Years: 2, YearsFraction: 500000000, Days: 1, DaysFraction: 1},
+			expected: "P2.5Y1.000000001D",
+		},
+		{
+			name:     "fraction only",
+			input:    chrono.Period{MonthsFraction: 100000000},
+			expected: "P0.1M",
+		},
+		{
+			name:     "unnormalized fraction",
+			input:    chrono.Period{Years: 1, YearsFraction: 1500000000, Weeks: 1, WeeksFraction: -250000000},
+			expected: "P2.5Y0.75W",
+		},
+		{
+			name:     "negative",
+			input:    chrono.Period{Months: -1, Days: -2, DaysFraction: -500000000},
+			expected: "-P1M2.5D",
+		},
+		{
+			name:     "mixed signs",
+			input:    chrono.Period{Years: 1, Months: -2, Days: 3},
+			expected: "P1Y-2M3D",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			if out := tt.input.String(); out != tt.expected {
@@ -97,6 +128,68 @@ func TestPeriod_Format(t *testing.T) {
 	}
 }
 
+func TestPeriod_Format_roundTrip(t *testing.T) {
+	for _, p := range []chrono.Period{
+		{Months: 1},
+		{Years: 1, Months: 2, Weeks: 3, Days: 4},
+		{Days: 1, DaysFraction: 500000000},
+		{Years: 1, Days: -3},
+		{Months: -1, WeeksFraction: 250000000},
+		{Years: 1, Months: -2, Days: 3},
+	} {
+		for _, p := range []chrono.Period{p, p.Negate()} {
+			t.Run(p.String(), func(t *testing.T) {
+				var out chrono.Period
+				if err := out.Parse(p.String()); err != nil {
+					t.Errorf("failed to parse period: %v", err)
+				} else if !out.Equal(p) {
+					t.Errorf("parsed period = %#v, want %#v", out, p)
+				}
+
+				if out, d, err := chrono.ParseDuration(p.String()); err != nil {
+					t.Errorf("failed to parse duration: %v", err)
+				} else if !out.Equal(p) || d.Compare(chrono.Duration{}) != 0 {
+					t.Errorf("parsed duration = %#v, %v, want %#v, 0s", out, d, p)
+				}
+			})
+		}
+	}
+
+	if str := (chrono.Period{Months: 1}).Negate().String(); str != "-P1M" {
+		t.Errorf("formatted period = %s, want -P1M", str)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		period   chrono.Period
+		duration chrono.Duration
+		expected string
+	}{
+		{"positive", chrono.Period{Days: 1}, chrono.DurationOf(chrono.Hour), "P1DT1H"},
+		{"negative", chrono.Period{Days: -1}, chrono.DurationOf(-chrono.Hour), "-P1DT1H"},
+		{"negative period", chrono.Period{Months: -1}, chrono.Duration{}, "-P1MT0S"},
+		{"negative duration", chrono.Period{}, chrono.DurationOf(-chrono.Hour), "-P0DT1H"},
+		{"positive period and negative duration", chrono.Period{Days: 1}, chrono.DurationOf(-(chrono.Hour + 30*chrono.Minute)), "P1DT-1H-30M"},
+		{"negative period and positive duration", chrono.Period{Days: -1}, chrono.DurationOf(chrono.Hour), "P-1DT1H"},
+		{"mixed period", chrono.Period{Months: 1, Days: -1}, chrono.DurationOf(-1500 * chrono.Millisecond), "P1M-1DT-1.5S"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			str := chrono.FormatDuration(tt.period, tt.duration)
+			if str != tt.expected {
+				t.Errorf("chrono.FormatDuration() = %s, want %s", str, tt.expected)
+			}
+
+			if p, d, err := chrono.ParseDuration(str); err != nil {
+				t.Errorf("failed to parse duration: %v", err)
+			} else if !p.Equal(tt.period) || d.Compare(tt.duration) != 0 {
+				t.Errorf("parsed duration = %v, %v, want %v, %v", p, d, tt.period, tt.duration)
+			}
+		})
+	}
+}
+
 func TestPeriod_Parse(t *testing.T) {
 	for _, tt := range []struct {
 		name     string
@@ -163,6 +256,31 @@ func TestPeriod_Parse(t *testing.T) {
 			input:    "P2D",
 			expected: chrono.Period{Days: 2},
 		},
+		{
+			name:     "fractional Y",
+			input:    "P0.1Y",
+			expected: chrono.Period{YearsFraction: 100000000},
+		},
+		{
+			name:     "fractional YMWD",
+			input:    "P1.25Y2,5M3.000000001W4.123456789123D",
+			expected: chrono.Period{Years: 1, YearsFraction: 250000000, Months: 2, MonthsFraction: 500000000, Weeks: 3, WeeksFraction: 1, Days: 4, DaysFraction: 123456789},
+		},
+		{
+			name:     "negative",
+			input:    "-P1Y2M",
+			expected: chrono.Period{Years: -1, Months: -2},
+		},
+		{
+			name:     "negative fractional",
+			input:    "-P1.5D",
+			expected: chrono.Period{Days: -1, DaysFraction: -500000000},
+		},
+		{
+			name:     "positive sign",
+			input:    "+P1M",
+			expected: chrono.Period{Months: 1},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			var p chrono.Period
@@ -185,7 +303,7 @@ func TestParseDuration(t *testing.T) {
 		{
 			name:     "period only",
 			input:    "P2.5Y",
-			period:   chrono.Period{Years: 2.5},
+			period:   chrono.Period{Years: 2, YearsFraction: 500000000},
 			duration: chrono.Duration{},
 		},
 		{
@@ -197,9 +315,15 @@ func TestParseDuration(t *testing.T) {
 		{
 			name:     "both period and duration",
 			input:    "P2.5YT6.5H",
-			period:   chrono.Period{Years: 2.5},
+			period:   chrono.Period{Years: 2, YearsFraction: 500000000},
 			duration: chrono.DurationOf((6 * chrono.Hour) + (30 * chrono.Minute)),
 		},
+		{
+			name:     "negative",
+			input:    "-P1DT1.5H",
+			period:   chrono.Period{Days: -1},
+			duration: chrono.DurationOf(-(chrono.Hour + 30*chrono.Minute)),
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			run := func() {
@@ -234,3 +358,182 @@ func TestParseDuration(t *testing.T) {
 		}
 	})
 }
+
+func TestPeriod_Equal(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		p1       chrono.Period
+		p2       chrono.Period
+		expected bool
+	}{
+		{"identical", chrono.Period{Years: 1, Months: 2}, chrono.Period{Years: 1, Months: 2}, true},
+		{"identical fractions", chrono.Period{Days: 1, DaysFraction: 500000000}, chrono.Period{Days: 1, DaysFraction: 500000000}, true},
+		{"months to years", chrono.Period{Months: 14}, chrono.Period{Years: 1, Months: 2}, false},
+		{"weeks to days", chrono.Period{Weeks: 2, Days: 3}, chrono.Period{Days: 17}, false},
+		{"different fractions", chrono.Period{Days: 1, DaysFraction: 500000000}, chrono.Period{Days: 1}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if eq := tt.p1.Equal(tt.p2); eq != tt.expected {
+				t.Errorf("%v.Equal(%v) = %t, want %t", tt.p1, tt.p2, eq, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPeriod_Equivalent(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		p1       chrono.Period
+		p2       chrono.Period
+		expected bool
+	}{
+		{"identical", chrono.Period{Years: 1, Months: 2}, chrono.Period{Years: 1, Months: 2}, true},
+		{"months to years", chrono.Period{Months: 14}, chrono.Period{Years: 1, Months: 2}, true},
+		{"weeks to days", chrono.Period{Weeks: 2, Days: 3}, chrono.Period{Days: 17}, true},
+		{"fractional year", chrono.Period{Years: 2, YearsFraction: 500000000}, chrono.Period{Years: 2, Months: 6}, true},
+		{"fractional week", chrono.Period{WeeksFraction: 500000000}, chrono.Period{Days: 3, DaysFraction: 500000000}, true},
+		{"mixed signs", chrono.Period{Years: 1, Months: -2}, chrono.Period{Months: 10}, true},
+		{"different", chrono.Period{Months: 1}, chrono.Period{Days: 30}, false},
+		{"different sign", chrono.Period{Days: 1}, chrono.Period{Days: -1}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if eq := tt.p1.Equivalent(tt.p2); eq != tt.expected {
+				t.Errorf("%v.Equivalent(%v) = %t, want %t", tt.p1, tt.p2, eq, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPeriod_Normalized(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		input    chrono.Period
+		expected chrono.Period
+	}{
+		{"zero", chrono.Period{}, chrono.Period{}},
+		{"months", chrono.Period{Months: 14}, chrono.Period{Years: 1, Months: 2}},
+		{"weeks", chrono.Period{Weeks: 2, Days: 3}, chrono.Period{Days: 17}},
+		{"negative months", chrono.Period{Years: -1, Months: -14}, chrono.Period{Years: -2, Months: -2}},
+		{"mixed signs", chrono.Period{Years: -1, Months: 2}, chrono.Period{Months: -10}},
+		{"fractional year", chrono.Period{YearsFraction: 100000000}, chrono.Period{Months: 1, MonthsFraction: 200000000}},
+		{"fractional week", chrono.Period{Weeks: 1, WeeksFraction: 500000000}, chrono.Period{Days: 10, DaysFraction: 500000000}},
+		{"carried fraction", chrono.Period{Months: 11, MonthsFraction: 1500000000}, chrono.Period{Years: 1, MonthsFraction: 500000000}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.input.Normalized(); out != tt.expected {
+				t.Errorf("%v.Normalized() = %#v, want %#v", tt.input, out, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPeriod_Add(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		p1       chrono.Period
+		p2       chrono.Period
+		expected chrono.Period
+	}{
+		{"zero", chrono.Period{}, chrono.Period{}, chrono.Period{}},
+		{"components", chrono.Period{Years: 1, Months: 2}, chrono.Period{Months: 11, Weeks: 1, Days: 3}, chrono.Period{Years: 1, Months: 13, Weeks: 1, Days: 3}},
+		{"negative", chrono.Period{Days: 3}, chrono.Period{Days: -5}, chrono.Period{Days: -2}},
+		{"fractions", chrono.Period{Years: 1, YearsFraction: 750000000}, chrono.Period{YearsFraction: 500000000}, chrono.Period{Years: 2, YearsFraction: 250000000}},
+		{"fractions with different signs", chrono.Period{Days: 1, DaysFraction: 250000000}, chrono.Period{Days: -1, DaysFraction: -500000000}, chrono.Period{DaysFraction: -250000000}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.p1.CanAdd(tt.p2); !ok {
+				t.Errorf("%v.CanAdd(%v) = false, want true", tt.p1, tt.p2)
+			}
+
+			if out := tt.p1.Add(tt.p2); out != tt.expected {
+				t.Errorf("%v.Add(%v) = %#v, want %#v", tt.p1, tt.p2, out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("overflows", func(t *testing.T) {
+		p := chrono.Period{Days: maxInt}
+		if p.CanAdd(chrono.Period{Days: 1}) {
+			t.Error("expecting CanAdd to return false")
+		}
+
+		if p.CanAdd(chrono.Period{DaysFraction: 1000000000}) {
+			t.Error("expecting CanAdd to return false")
+		}
+	})
+}
+
+func TestPeriod_Sub(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		p1       chrono.Period
+		p2       chrono.Period
+		expected chrono.Period
+	}{
+		{"components", chrono.Period{Years: 1, Months: 2, Days: 10}, chrono.Period{Months: 3, Weeks: 1}, chrono.Period{Years: 1, Months: -1, Weeks: -1, Days: 10}},
+		{"fractions", chrono.Period{Months: 1}, chrono.Period{MonthsFraction: 250000000}, chrono.Period{MonthsFraction: 750000000}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.p1.CanSub(tt.p2); !ok {
+				t.Errorf("%v.CanSub(%v) = false, want true", tt.p1, tt.p2)
+			}
+
+			if out := tt.p1.Sub(tt.p2); out != tt.expected {
+				t.Errorf("%v.Sub(%v) = %#v, want %#v", tt.p1, tt.p2, out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("underflows", func(t *testing.T) {
+		if (chrono.Period{Months: -2}).CanSub(chrono.Period{Months: maxInt}) {
+			t.Error("expecting CanSub to return false")
+		}
+
+		if (chrono.Period{}).CanSub(chrono.Period{Months: minInt}) {
+			t.Error("expecting CanSub to return false")
+		}
+	})
+}
+
+func TestPeriod_Negate(t *testing.T) {
+	p := chrono.Period{Years: 1, Months: -2, Weeks: 3, Days: 4, DaysFraction: 500000000}
+	expected := chrono.Period{Years: -1, Months: 2, Weeks: -3, Days: -4, DaysFraction: -500000000}
+	if out := p.Negate(); out != expected {
+		t.Errorf("%v.Negate() = %#v, want %#v", p, out, expected)
+	}
+}
+
+func TestPeriod_Multiply(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		p        chrono.Period
+		n        int
+		expected chrono.Period
+	}{
+		{"zero", chrono.Period{Years: 1, Days: 2}, 0, chrono.Period{}},
+		{"positive", chrono.Period{Years: 1, Months: 2, Weeks: 3, Days: 4}, 3, chrono.Period{Years: 3, Months: 6, Weeks: 9, Days: 12}},
+		{"negative", chrono.Period{Months: 5}, -2, chrono.Period{Months: -10}},
+		{"fractions", chrono.Period{Years: 1, YearsFraction: 250000000, DaysFraction: 100000000}, 6, chrono.Period{Years: 7, YearsFraction: 500000000, DaysFraction: 600000000}},
+		{"negative fractions", chrono.Period{Days: 1, DaysFraction: 500000000}, -3, chrono.Period{Days: -4, DaysFraction: -500000000}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := tt.p.CanMultiply(tt.n); !ok {
+				t.Errorf("%v.CanMultiply(%d) = false, want true", tt.p, tt.n)
+			}
+
+			if out := tt.p.Multiply(tt.n); out != tt.expected {
+				t.Errorf("%v.Multiply(%d) = %#v, want %#v", tt.p, tt.n, out, tt.expected)
+			}
+		})
+	}
+
+	t.Run("overflows", func(t *testing.T) {
+		if (chrono.Period{Years: maxInt / 2}).CanMultiply(3) {
+			t.Error("expecting CanMultiply to return false")
+		}
+
+		if (chrono.Period{Years: minInt}).CanMultiply(-1) {
+			t.Error("expecting CanMultiply to return false")
+		}
+	})
+}
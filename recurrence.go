@@ -0,0 +1,1004 @@
+package chrono
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Frequency specifies the period at which a [RecurrenceRule] repeats.
+type Frequency int
+
+// Frequencies of recurrence rules, as defined by RFC 5545.
+const (
+	FrequencySecondly Frequency = iota + 1
+	FrequencyMinutely
+	FrequencyHourly
+	FrequencyDaily
+	FrequencyWeekly
+	FrequencyMonthly
+	FrequencyYearly
+)
+
+// String returns the name of f as used in a recurrence rule, such as WEEKLY.
+func (f Frequency) String() string {
+	if f < FrequencySecondly || f > FrequencyYearly {
+		return fmt.Sprintf("%%!Frequency(%d)", f)
+	}
+	return frequencyNames[f-1]
+}
+
+var frequencyNames = [7]string{
+	FrequencySecondly - 1: "SECONDLY",
+	FrequencyMinutely - 1: "MINUTELY",
+	FrequencyHourly - 1:   "HOURLY",
+	FrequencyDaily - 1:    "DAILY",
+	FrequencyWeekly - 1:   "WEEKLY",
+	FrequencyMonthly - 1:  "MONTHLY",
+	FrequencyYearly - 1:   "YEARLY",
+}
+
+// RecurrenceWeekday specifies a day of the week in a [RecurrenceRule]. If N is non-zero, it specifies
+// the Nth such day of the month or year, counting backwards from the end if negative, such that
+// {2, Tuesday} is the second Tuesday, and {-1, Friday} is the last Friday. If N is zero, it specifies every such day.
+type RecurrenceWeekday struct {
+	N       int
+	Weekday Weekday
+}
+
+// String returns d formatted as in a recurrence rule, such as 2TU or -1FR.
+func (d RecurrenceWeekday) String() string {
+	var out string
+	if d.N != 0 {
+		out = strconv.Itoa(d.N)
+	}
+
+	if d.Weekday < Monday || d.Weekday > Sunday {
+		return out + fmt.Sprintf("%%!Weekday(%d)", d.Weekday)
+	}
+	return out + weekdayCodes[d.Weekday-1]
+}
+
+var weekdayCodes = [7]string{
+	Monday - 1:    "MO",
+	Tuesday - 1:   "TU",
+	Wednesday - 1: "WE",
+	Thursday - 1:  "TH",
+	Friday - 1:    "FR",
+	Saturday - 1:  "SA",
+	Sunday - 1:    "SU",
+}
+
+// RecurrenceRule is a recurrence rule as defined by RFC 5545 (RRULE), which can express repetitions that
+// ISO 8601 repeating intervals cannot, such as every second Tuesday, or the last weekday of the month.
+//
+// The BY rule parts either expand the occurrences within each period of Frequency, or limit them, according to RFC 5545.
+// Negative values of ByMonthDay, ByYearDay, ByWeekNo and BySetPos count backwards from the end of the month, year, or period.
+// Weeks are numbered as in ISO 8601, except that they start on WeekStart.
+type RecurrenceRule struct {
+	Frequency Frequency
+	// Interval is the number of periods of Frequency between each repetition. A value of 0 is the same as 1.
+	Interval int
+	// Count is the number of occurrences, including the start. A value of 0 means that the number of occurrences is not limited.
+	Count int
+	// Until is the last time point at which an occurrence can fall, and is one of *LocalDate, *LocalDateTime, or *OffsetDateTime.
+	// A *LocalDate includes the whole day. If nil, the occurrences are not limited. Count and Until must not both be set.
+	Until      Chronological
+	BySecond   []int
+	ByMinute   []int
+	ByHour     []int
+	ByDay      []RecurrenceWeekday
+	ByMonthDay []int
+	ByYearDay  []int
+	ByWeekNo   []int
+	ByMonth    []Month
+	BySetPos   []int
+	// WeekStart is the day on which weeks start. A value of 0 is the same as Monday.
+	WeekStart Weekday
+}
+
+const (
+	recurrenceDateLayout          = "%Y%m%d"
+	recurrenceLocalDateTimeLayout = "%Y%m%dT%H%M%S"
+	recurrenceUTCDateTimeLayout   = "%Y%m%dT%H%M%SZ"
+)
+
+// ParseRecurrenceRule parses a recurrence rule according to RFC 5545, such as FREQ=MONTHLY;BYDAY=-1FR,
+// optionally preceded by RRULE:. The FREQ, INTERVAL, COUNT, UNTIL, BYSECOND, BYMINUTE, BYHOUR, BYDAY,
+// BYMONTHDAY, BYYEARDAY, BYWEEKNO, BYMONTH, BYSETPOS and WKST rule parts are supported.
+func ParseRecurrenceRule(s string) (RecurrenceRule, error) {
+	if strings.HasPrefix(strings.ToUpper(s), "RRULE:") {
+		s = s[len("RRULE:"):]
+	}
+
+	if len(s) == 0 {
+		return RecurrenceRule{}, fmt.Errorf("empty string")
+	}
+
+	var out RecurrenceRule
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		i := strings.IndexByte(part, '=')
+		if i < 0 {
+			return RecurrenceRule{}, fmt.Errorf("parsing recurrence rule: invalid rule part %q", part)
+		}
+
+		name, value := strings.ToUpper(part[:i]), strings.ToUpper(part[i+1:])
+		if seen[name] {
+			return RecurrenceRule{}, fmt.Errorf("parsing recurrence rule: duplicate rule part %s", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			out.Frequency, err = parseFrequency(value)
+		case "INTERVAL":
+			out.Interval, err = parseRecurrenceInt(value, 1)
+		case "COUNT":
+			out.Count, err = parseRecurrenceInt(value, 1)
+		case "UNTIL":
+			out.Until, err = parseRecurrenceUntil(value)
+		case "BYSECOND":
+			out.BySecond, err = parseRecurrenceInts(value)
+		case "BYMINUTE":
+			out.ByMinute, err = parseRecurrenceInts(value)
+		case "BYHOUR":
+			out.ByHour, err = parseRecurrenceInts(value)
+		case "BYDAY":
+			out.ByDay, err = parseRecurrenceWeekdays(value)
+		case "BYMONTHDAY":
+			out.ByMonthDay, err = parseRecurrenceInts(value)
+		case "BYYEARDAY":
+			out.ByYearDay, err = parseRecurrenceInts(value)
+		case "BYWEEKNO":
+			out.ByWeekNo, err = parseRecurrenceInts(value)
+		case "BYMONTH":
+			var months []int
+			months, err = parseRecurrenceInts(value)
+			for _, m := range months {
+				out.ByMonth = append(out.ByMonth, Month(m))
+			}
+		case "BYSETPOS":
+			out.BySetPos, err = parseRecurrenceInts(value)
+		case "WKST":
+			out.WeekStart, err = parseWeekdayCode(value)
+		default:
+			err = fmt.Errorf("unsupported rule part")
+		}
+
+		if err != nil {
+			return RecurrenceRule{}, fmt.Errorf("parsing recurrence rule: %s: %v", name, err)
+		}
+	}
+
+	if out.Frequency == 0 {
+		return RecurrenceRule{}, fmt.Errorf("parsing recurrence rule: missing FREQ")
+	} else if err := out.validate(); err != nil {
+		return RecurrenceRule{}, fmt.Errorf("parsing recurrence rule: %v", err)
+	}
+	return out, nil
+}
+
+func parseFrequency(s string) (Frequency, error) {
+	for i, name := range frequencyNames {
+		if s == name {
+			return Frequency(i + 1), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid frequency %q", s)
+}
+
+func parseWeekdayCode(s string) (Weekday, error) {
+	for i, code := range weekdayCodes {
+		if s == code {
+			return Weekday(i + 1), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", s)
+}
+
+func parseRecurrenceInt(s string, min int) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < min {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	return v, nil
+}
+
+func parseRecurrenceInts(s string) ([]int, error) {
+	var out []int
+	for _, str := range strings.Split(s, ",") {
+		v, err := strconv.Atoi(str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", str)
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func parseRecurrenceWeekdays(s string) ([]RecurrenceWeekday, error) {
+	var out []RecurrenceWeekday
+	for _, str := range strings.Split(s, ",") {
+		if len(str) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", str)
+		}
+
+		var d RecurrenceWeekday
+		var err error
+		if d.Weekday, err = parseWeekdayCode(str[len(str)-2:]); err != nil {
+			return nil, err
+		}
+
+		if n := str[:len(str)-2]; n != "" {
+			if d.N, err = strconv.Atoi(n); err != nil || d.N == 0 {
+				return nil, fmt.Errorf("invalid weekday %q", str)
+			}
+		}
+		out = append(out, d)
+	}
+	return out, nil
+}
+
+func parseRecurrenceUntil(s string) (Chronological, error) {
+	var date, time int64
+	switch {
+	case len(s) == len("20060102"):
+		if err := parseDateAndTime(recurrenceDateLayout, s, &date, nil, nil); err != nil {
+			return nil, err
+		}
+		out := LocalDate(date)
+		return &out, nil
+	case strings.HasSuffix(s, "Z"):
+		if err := parseDateAndTime(recurrenceUTCDateTimeLayout, s, &date, &time, nil); err != nil {
+			return nil, err
+		}
+		return &OffsetDateTime{v: makeDateTime(date, time)}, nil
+	default:
+		if err := parseDateAndTime(recurrenceLocalDateTimeLayout, s, &date, &time, nil); err != nil {
+			return nil, err
+		}
+		return &LocalDateTime{v: makeDateTime(date, time)}, nil
+	}
+}
+
+// String returns r formatted according to RFC 5545, without the RRULE: prefix.
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + r.Frequency.String()}
+	switch until := r.Until.(type) {
+	case *LocalDate:
+		parts = append(parts, "UNTIL="+until.Format(recurrenceDateLayout))
+	case *LocalDateTime:
+		parts = append(parts, "UNTIL="+until.Format(recurrenceLocalDateTimeLayout))
+	case *OffsetDateTime:
+		parts = append(parts, "UNTIL="+until.In(UTC).Format(recurrenceUTCDateTimeLayout))
+	}
+
+	if r.Count != 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	parts = appendRecurrenceInts(parts, "BYSECOND", r.BySecond)
+	parts = appendRecurrenceInts(parts, "BYMINUTE", r.ByMinute)
+	parts = appendRecurrenceInts(parts, "BYHOUR", r.ByHour)
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = d.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	parts = appendRecurrenceInts(parts, "BYMONTHDAY", r.ByMonthDay)
+	parts = appendRecurrenceInts(parts, "BYYEARDAY", r.ByYearDay)
+	parts = appendRecurrenceInts(parts, "BYWEEKNO", r.ByWeekNo)
+
+	if len(r.ByMonth) > 0 {
+		months := make([]int, len(r.ByMonth))
+		for i, m := range r.ByMonth {
+			months[i] = int(m)
+		}
+		parts = appendRecurrenceInts(parts, "BYMONTH", months)
+	}
+
+	parts = appendRecurrenceInts(parts, "BYSETPOS", r.BySetPos)
+
+	if r.WeekStart != 0 {
+		parts = append(parts, "WKST="+RecurrenceWeekday{Weekday: r.WeekStart}.String())
+	}
+	return strings.Join(parts, ";")
+}
+
+func appendRecurrenceInts(parts []string, name string, values []int) []string {
+	if len(values) == 0 {
+		return parts
+	}
+
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = strconv.Itoa(v)
+	}
+	return append(parts, name+"="+strings.Join(strs, ","))
+}
+
+func (r RecurrenceRule) validate() error {
+	switch {
+	case r.Frequency < FrequencySecondly || r.Frequency > FrequencyYearly:
+		return fmt.Errorf("invalid frequency")
+	case r.Interval < 0:
+		return fmt.Errorf("invalid interval")
+	case r.Count < 0:
+		return fmt.Errorf("invalid count")
+	case r.Count != 0 && r.Until != nil:
+		return fmt.Errorf("COUNT and UNTIL must not both be set")
+	case r.WeekStart < 0 || r.WeekStart > Sunday:
+		return fmt.Errorf("invalid week start")
+	}
+
+	switch r.Until.(type) {
+	case nil, *LocalDate, *LocalDateTime, *OffsetDateTime:
+	default:
+		return fmt.Errorf("unsupported type of UNTIL %T", r.Until)
+	}
+
+	for _, check := range []struct {
+		name     string
+		values   []int
+		min, max int
+		negative bool
+	}{
+		{"BYSECOND", r.BySecond, 0, 59, false},
+		{"BYMINUTE", r.ByMinute, 0, 59, false},
+		{"BYHOUR", r.ByHour, 0, 23, false},
+		{"BYMONTHDAY", r.ByMonthDay, 1, 31, true},
+		{"BYYEARDAY", r.ByYearDay, 1, 366, true},
+		{"BYWEEKNO", r.ByWeekNo, 1, 53, true},
+		{"BYSETPOS", r.BySetPos, 1, 366, true},
+	} {
+		for _, v := range check.values {
+			if check.negative && v < 0 {
+				v = -v
+			}
+
+			if v < check.min || v > check.max {
+				return fmt.Errorf("invalid %s value %d", check.name, v)
+			}
+		}
+	}
+
+	for _, m := range r.ByMonth {
+		if m < January || m > December {
+			return fmt.Errorf("invalid BYMONTH value %d", m)
+		}
+	}
+
+	for _, d := range r.ByDay {
+		switch {
+		case d.Weekday < Monday || d.Weekday > Sunday:
+			return fmt.Errorf("invalid BYDAY weekday %d", d.Weekday)
+		case d.N == 0:
+		case r.Frequency == FrequencyMonthly && d.N >= -5 && d.N <= 5:
+		case r.Frequency == FrequencyYearly && len(r.ByWeekNo) == 0 && d.N >= -53 && d.N <= 53:
+		default:
+			return fmt.Errorf("invalid BYDAY value %s with FREQ=%s", d, r.Frequency)
+		}
+	}
+
+	switch {
+	case len(r.ByMonthDay) > 0 && r.Frequency == FrequencyWeekly:
+		return fmt.Errorf("BYMONTHDAY must not be used with FREQ=%s", r.Frequency)
+	case len(r.ByYearDay) > 0 && (r.Frequency == FrequencyDaily || r.Frequency == FrequencyWeekly || r.Frequency == FrequencyMonthly):
+		return fmt.Errorf("BYYEARDAY must not be used with FREQ=%s", r.Frequency)
+	case len(r.ByWeekNo) > 0 && r.Frequency != FrequencyYearly:
+		return fmt.Errorf("BYWEEKNO must not be used with FREQ=%s", r.Frequency)
+	case len(r.BySetPos) > 0 && len(r.BySecond)+len(r.ByMinute)+len(r.ByHour)+len(r.ByDay)+
+		len(r.ByMonthDay)+len(r.ByYearDay)+len(r.ByWeekNo)+len(r.ByMonth) == 0:
+		return fmt.Errorf("BYSETPOS must be used with another BY rule part")
+	}
+	return nil
+}
+
+// Cursor returns a [RecurrenceCursor] that iterates over the occurrences of r that start at start,
+// combined with the additional occurrences in rdates (RDATE), and excluding the occurrences in exdates (EXDATE).
+// If Until is an *OffsetDateTime, it is converted to UTC.
+func (r RecurrenceRule) Cursor(start LocalDateTime, rdates, exdates []LocalDateTime) (*RecurrenceCursor, error) {
+	toDateTimes := func(values []LocalDateTime) []dateTime {
+		out := make([]dateTime, len(values))
+		for i, v := range values {
+			out[i] = v.v
+		}
+		return out
+	}
+	return newRecurrenceCursor(r, start.v, 0, toDateTimes(rdates), toDateTimes(exdates))
+}
+
+// OffsetCursor returns a [RecurrenceCursor] that iterates over the occurrences of r that start at start,
+// combined with the additional occurrences in rdates (RDATE), and excluding the occurrences in exdates (EXDATE).
+// The rule is evaluated in the offset of start, to which rdates, exdates and Until are converted.
+func (r RecurrenceRule) OffsetCursor(start OffsetDateTime, rdates, exdates []OffsetDateTime) (*RecurrenceCursor, error) {
+	toDateTimes := func(values []OffsetDateTime) []dateTime {
+		out := make([]dateTime, len(values))
+		for i, v := range values {
+			out[i] = dateTimeToOffset(v.v, v.o, start.o)
+		}
+		return out
+	}
+	return newRecurrenceCursor(r, start.v, start.o, toDateTimes(rdates), toDateTimes(exdates))
+}
+
+// RecurrenceCursor iterates over the occurrences of a [RecurrenceRule], including a rule that repeats indefinitely.
+// As required by RFC 5545, the start is always the first occurrence, and counts towards Count, even if it does not match the rule.
+// Occurrences that would fall outside of the supported range of dates, or that would not be reached
+// within 400 years multiplied by Interval of the previous occurrence, are not returned. Neither are those of a rule
+// of frequency HOURLY or shorter that would not be reached within 4194304 consecutive periods without an occurrence.
+//
+// A cursor is used as follows:
+//
+//	c, err := r.Cursor(start, nil, nil)
+//	if err != nil {
+//		...
+//	}
+//
+//	for c.Next() {
+//		v := c.LocalDateTime()
+//		...
+//	}
+//
+//	if err := c.Err(); err != nil {
+//		...
+//	}
+type RecurrenceCursor struct {
+	r        RecurrenceRule
+	interval int64
+	start    dateTime
+	offset   int64
+	until    *dateTime
+	rdates   []dateTime
+	exdates  []dateTime
+
+	// The values that each time component takes in each period of DAILY or longer.
+	hours, minutes, seconds []int
+
+	period   int64
+	pending  []dateTime
+	next     *dateTime
+	emitted  int
+	horizon  int64
+	misses   int
+	ruleDone bool
+
+	cur dateTime
+	n   int
+	err error
+}
+
+// maxRecurrenceMisses is the number of consecutive periods of a rule of frequency HOURLY or shorter
+// without an occurrence, after which no further occurrences are sought.
+const maxRecurrenceMisses = 1 << 22
+
+func newRecurrenceCursor(r RecurrenceRule, start dateTime, offset int64, rdates, exdates []dateTime) (*RecurrenceCursor, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	c := &RecurrenceCursor{
+		r:        r,
+		interval: int64(r.Interval),
+		start:    start,
+		offset:   offset,
+		rdates:   sortDateTimes(rdates),
+		exdates:  sortDateTimes(exdates),
+	}
+
+	if c.interval == 0 {
+		c.interval = 1
+	}
+
+	if c.r.WeekStart == 0 {
+		c.r.WeekStart = Monday
+	}
+
+	switch until := r.Until.(type) {
+	case *LocalDate:
+		c.until = &dateTime{date: int64(*until), time: oneDay - 1}
+	case *LocalDateTime:
+		c.until = &until.v
+	case *OffsetDateTime:
+		v := dateTimeToOffset(until.v, until.o, offset)
+		c.until = &v
+	}
+
+	_, month, day, err := fromDate(start.date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the default rule parts that are derived from the start.
+	switch r.Frequency {
+	case FrequencyYearly:
+		if len(r.ByWeekNo)+len(r.ByYearDay)+len(r.ByMonthDay)+len(r.ByDay) == 0 {
+			if len(r.ByMonth) == 0 {
+				c.r.ByMonth = []Month{Month(month)}
+			}
+			c.r.ByMonthDay = []int{day}
+		} else if len(r.ByWeekNo) > 0 && len(r.ByYearDay)+len(r.ByMonthDay)+len(r.ByDay) == 0 {
+			c.r.ByDay = []RecurrenceWeekday{{Weekday: Weekday(getWeekday(int32(start.date)))}}
+		}
+	case FrequencyMonthly:
+		if len(r.ByMonthDay)+len(r.ByDay) == 0 {
+			c.r.ByMonthDay = []int{day}
+		}
+	case FrequencyWeekly:
+		if len(r.ByDay) == 0 {
+			c.r.ByDay = []RecurrenceWeekday{{Weekday: Weekday(getWeekday(int32(start.date)))}}
+		}
+	}
+
+	hour, min, sec, _ := fromTime(start.time)
+	c.hours = sortedInts(r.ByHour, hour)
+	c.minutes = sortedInts(r.ByMinute, min)
+	c.seconds = sortedInts(r.BySecond, sec)
+	c.horizon = c.horizonAfter(start.date)
+	return c, nil
+}
+
+func sortDateTimes(values []dateTime) []dateTime {
+	sort.Slice(values, func(i, j int) bool {
+		return compareDateTimes(values[i], values[j]) == -1
+	})
+	return values
+}
+
+// sortedInts returns a sorted copy of values without duplicates, or def if values is empty.
+func sortedInts(values []int, def int) []int {
+	if len(values) == 0 {
+		return []int{def}
+	}
+
+	out := append([]int(nil), values...)
+	sort.Ints(out)
+
+	n := 1
+	for i := 1; i < len(out); i++ {
+		if out[i] != out[n-1] {
+			out[n] = out[i]
+			n++
+		}
+	}
+	return out[:n]
+}
+
+func containsInt(values []int, v int) bool {
+	for _, v2 := range values {
+		if v2 == v {
+			return true
+		}
+	}
+	return false
+}
+
+// horizonAfter returns the date after which no further occurrences are sought if none are found following date.
+func (c *RecurrenceCursor) horizonAfter(date int64) int64 {
+	const daysIn400Years = 146097
+	if c.interval > (maxJDN-minJDN)/daysIn400Years {
+		return maxJDN
+	}
+
+	if horizon := date + daysIn400Years*c.interval; horizon < maxJDN {
+		return horizon
+	}
+	return maxJDN
+}
+
+// Next advances the cursor to the next occurrence, which is then available through LocalDateTime and OffsetDateTime.
+// It returns false when there are no more occurrences, or if an error occurs, in which case Err returns the error.
+func (c *RecurrenceCursor) Next() bool {
+	if c.err != nil {
+		return false
+	}
+
+	for {
+		v, ok, err := c.peekRule()
+		if err != nil {
+			c.err = err
+			return false
+		}
+
+		switch {
+		case len(c.rdates) > 0 && (!ok || compareDateTimes(c.rdates[0], v) <= 0):
+			if ok && compareDateTimes(c.rdates[0], v) == 0 {
+				c.next = nil
+			}
+			v = c.rdates[0]
+			c.rdates = c.rdates[1:]
+		case ok:
+			c.next = nil
+		default:
+			return false
+		}
+
+		if c.n > 0 && compareDateTimes(v, c.cur) == 0 {
+			continue
+		}
+
+		for len(c.exdates) > 0 && compareDateTimes(c.exdates[0], v) == -1 {
+			c.exdates = c.exdates[1:]
+		}
+
+		if len(c.exdates) > 0 && compareDateTimes(c.exdates[0], v) == 0 {
+			continue
+		}
+
+		c.cur = v
+		c.n++
+		return true
+	}
+}
+
+// peekRule returns the next occurrence of the rule without consuming it.
+func (c *RecurrenceCursor) peekRule() (dateTime, bool, error) {
+	if c.next != nil {
+		return *c.next, true, nil
+	}
+
+	v, ok, err := c.nextRule()
+	if err != nil || !ok {
+		return dateTime{}, false, err
+	}
+
+	c.next = &v
+	return v, true, nil
+}
+
+// nextRule returns the next occurrence of the rule.
+func (c *RecurrenceCursor) nextRule() (dateTime, bool, error) {
+	if c.ruleDone || (c.r.Count > 0 && c.emitted >= c.r.Count) {
+		return dateTime{}, false, nil
+	}
+
+	var v dateTime
+	if c.emitted == 0 {
+		v = c.start
+	} else {
+		for len(c.pending) == 0 {
+			if c.ruleDone {
+				return dateTime{}, false, nil
+			}
+
+			if err := c.expand(); err != nil {
+				return dateTime{}, false, err
+			}
+		}
+
+		v = c.pending[0]
+		c.pending = c.pending[1:]
+	}
+
+	if c.until != nil && compareDateTimes(v, *c.until) == 1 {
+		c.ruleDone = true
+		return dateTime{}, false, nil
+	}
+
+	c.emitted++
+	c.horizon = c.horizonAfter(v.date)
+	c.misses = 0
+	return v, true, nil
+}
+
+// expand adds the occurrences of the current period that follow the start to pending, and moves to the next period.
+func (c *RecurrenceCursor) expand() error {
+	var dates []int64
+	var hours, minutes, seconds []int
+
+	switch c.r.Frequency {
+	case FrequencyYearly, FrequencyMonthly, FrequencyWeekly, FrequencyDaily:
+		first, last, ok, err := c.periodDates()
+		if err != nil {
+			return err
+		} else if !ok || first > c.horizon || (c.until != nil && first > c.until.date) {
+			c.ruleDone = true
+			return nil
+		}
+
+		for date := first; date <= last; date++ {
+			if ok, err := c.matchesDate(date); err != nil {
+				return err
+			} else if ok {
+				dates = append(dates, date)
+			}
+		}
+		hours, minutes, seconds = c.hours, c.minutes, c.seconds
+		c.period++
+	default:
+		unit := map[Frequency]int64{FrequencyHourly: 3600, FrequencyMinutely: 60, FrequencySecondly: 1}[c.r.Frequency]
+		step := c.interval * unit
+		base := c.start.date*86400 + c.start.time/oneSecond/unit*unit
+
+		if c.period > (maxJDN-minJDN+1)*86400/step {
+			c.ruleDone = true
+			return nil
+		}
+
+		v := base + c.period*step
+		date, tod := floorDiv(v, 86400), floorMod(v, 86400)
+		if date > maxJDN || date > c.horizon || (c.until != nil && date > c.until.date) {
+			c.ruleDone = true
+			return nil
+		}
+
+		if c.misses++; c.misses > maxRecurrenceMisses {
+			c.ruleDone = true
+			return nil
+		}
+
+		hour, min, sec := int(tod/3600), int(tod/60%60), int(tod%60)
+
+		// Skip to the first period that starts after the boundary of any component that does not match.
+		var boundary int64
+		if ok, err := c.matchesDate(date); err != nil {
+			return err
+		} else if !ok {
+			boundary = (date + 1) * 86400
+		} else if len(c.r.ByHour) > 0 && !containsInt(c.r.ByHour, hour) {
+			boundary = v - tod%3600 + 3600
+		} else if len(c.r.ByMinute) > 0 && !containsInt(c.r.ByMinute, min) {
+			boundary = v - tod%60 + 60
+		} else if len(c.r.BySecond) > 0 && !containsInt(c.r.BySecond, sec) {
+			boundary = v + 1
+		}
+
+		if boundary != 0 {
+			c.period = (boundary - base + step - 1) / step
+			return nil
+		}
+		c.period++
+
+		dates, hours, minutes, seconds = []int64{date}, []int{hour}, c.minutes, c.seconds
+		if c.r.Frequency <= FrequencyMinutely {
+			minutes = []int{min}
+		}
+
+		if c.r.Frequency == FrequencySecondly {
+			seconds = []int{sec}
+		}
+	}
+
+	nsec := c.start.time % oneSecond
+	var candidates []dateTime
+	for _, date := range dates {
+		for _, hour := range hours {
+			for _, min := range minutes {
+				for _, sec := range seconds {
+					candidates = append(candidates, dateTime{
+						date: date,
+						time: int64(hour)*oneHour + int64(min)*oneMinute + int64(sec)*oneSecond + nsec,
+					})
+				}
+			}
+		}
+	}
+
+	if len(c.r.BySetPos) > 0 {
+		candidates = selectSetPos(candidates, c.r.BySetPos)
+	}
+
+	for _, v := range candidates {
+		if compareDateTimes(v, c.start) == 1 {
+			c.pending = append(c.pending, v)
+		}
+	}
+	return nil
+}
+
+// periodDates returns the first and last dates of the current period of a rule of frequency DAILY or longer.
+// If the period falls outside of the supported range of dates, ok is false.
+func (c *RecurrenceCursor) periodDates() (first, last int64, ok bool, err error) {
+	year, month, _, err := fromDate(c.start.date)
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	if c.period > (maxJDN-minJDN)/c.interval {
+		return 0, 0, false, nil
+	}
+	n := c.period * c.interval
+
+	switch c.r.Frequency {
+	case FrequencyYearly:
+		y := int64(year) + n
+		if y > maxYear {
+			return 0, 0, false, nil
+		}
+		first, last = makeJDN(y, int64(January), 1), makeJDN(y, int64(December), 31)
+	case FrequencyMonthly:
+		months := int64(year)*12 + int64(month) - 1 + n
+		y, m := floorDiv(months, 12), floorMod(months, 12)+1
+		if y > maxYear {
+			return 0, 0, false, nil
+		}
+		first = makeJDN(y, m, 1)
+		last = first + int64(getDaysInMonth(int(y), int(m))) - 1
+	case FrequencyWeekly:
+		first = c.weekStartOf(c.start.date) + 7*n
+		last = first + 6
+	default:
+		first = c.start.date + n
+		last = first
+	}
+
+	if first > maxJDN {
+		return 0, 0, false, nil
+	} else if first < minJDN {
+		first = minJDN
+	}
+
+	if last > maxJDN {
+		last = maxJDN
+	}
+	return first, last, true, nil
+}
+
+// weekStartOf returns the date on which the week containing date starts.
+func (c *RecurrenceCursor) weekStartOf(date int64) int64 {
+	return date - int64((getWeekday(int32(date))-int(c.r.WeekStart)+7)%7)
+}
+
+// weekOfYear returns the week of the year that contains date, and the number of weeks in that year. As in ISO 8601,
+// the first week of a year is the first that contains at least four days of the year, but weeks start on WeekStart.
+func (c *RecurrenceCursor) weekOfYear(date int64) (week, weeks int, err error) {
+	start := c.weekStartOf(date)
+	year, _, _, err := fromDate(start + 3)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	first := c.weekStartOf(makeJDN(int64(year), int64(January), 4))
+	next := c.weekStartOf(makeJDN(int64(year)+1, int64(January), 4))
+	return int((start-first)/7) + 1, int((next - first) / 7), nil
+}
+
+// matchesDate reports whether date satisfies each of the BY rule parts that apply to dates.
+func (c *RecurrenceCursor) matchesDate(date int64) (bool, error) {
+	year, month, day, err := fromDate(date)
+	if err != nil {
+		return false, err
+	}
+
+	if len(c.r.ByMonth) > 0 {
+		var found bool
+		for _, m := range c.r.ByMonth {
+			found = found || int(m) == month
+		}
+
+		if !found {
+			return false, nil
+		}
+	}
+
+	if len(c.r.ByWeekNo) > 0 {
+		week, weeks, err := c.weekOfYear(date)
+		if err != nil {
+			return false, err
+		} else if !containsInt(c.r.ByWeekNo, week) && !containsInt(c.r.ByWeekNo, week-weeks-1) {
+			return false, nil
+		}
+	}
+
+	if len(c.r.ByYearDay) > 0 {
+		yday := getOrdinalDate(year, month, day)
+		if !containsInt(c.r.ByYearDay, yday) && !containsInt(c.r.ByYearDay, yday-getDaysInYear(year)-1) {
+			return false, nil
+		}
+	}
+
+	if len(c.r.ByMonthDay) > 0 {
+		if !containsInt(c.r.ByMonthDay, day) && !containsInt(c.r.ByMonthDay, day-getDaysInMonth(year, month)-1) {
+			return false, nil
+		}
+	}
+
+	if len(c.r.ByDay) > 0 {
+		weekday := Weekday(getWeekday(int32(date)))
+
+		var found bool
+		for _, d := range c.r.ByDay {
+			if d.Weekday == weekday && (d.N == 0 || c.nthWeekday(year, month, d) == date) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// nthWeekday returns the date of the Nth weekday specified by d within the month of a MONTHLY rule,
+// or a YEARLY rule with BYMONTH, and otherwise within the year. If there is no such date, -1 is returned.
+func (c *RecurrenceCursor) nthWeekday(year, month int, d RecurrenceWeekday) int64 {
+	if c.r.Frequency == FrequencyYearly && len(c.r.ByMonth) == 0 {
+		month = int(January)
+		if d.N < 0 {
+			year++
+		}
+	} else if d.N < 0 {
+		year, month = addMonths(year, month, 1)
+	}
+
+	if !isDateInBounds(year, month, 1) || !isDateInBounds(year, month, 7) {
+		return -1
+	}
+
+	first := int64(OfFirstWeekday(year, Month(month), d.Weekday))
+	if d.N > 0 {
+		return first + 7*int64(d.N-1)
+	}
+	return first + 7*int64(d.N)
+}
+
+// selectSetPos returns the candidates at the positions specified by BYSETPOS, in order.
+func selectSetPos(candidates []dateTime, positions []int) []dateTime {
+	var indices []int
+	for _, pos := range positions {
+		i := pos - 1
+		if pos < 0 {
+			i = len(candidates) + pos
+		}
+
+		if i >= 0 && i < len(candidates) {
+			indices = append(indices, i)
+		}
+	}
+
+	if len(indices) == 0 {
+		return nil
+	}
+
+	indices = sortedInts(indices, 0)
+	out := make([]dateTime, len(indices))
+	for i, v := range indices {
+		out[i] = candidates[v]
+	}
+	return out
+}
+
+func floorDiv(x, y int64) int64 {
+	q := x / y
+	if (x%y != 0) && ((x < 0) != (y < 0)) {
+		q--
+	}
+	return q
+}
+
+func floorMod(x, y int64) int64 {
+	return x - floorDiv(x, y)*y
+}
+
+// LocalDateTime returns the current occurrence.
+func (c *RecurrenceCursor) LocalDateTime() LocalDateTime {
+	return LocalDateTime{v: c.cur}
+}
+
+// OffsetDateTime returns the current occurrence in the offset of the start, or UTC for a cursor returned by Cursor.
+func (c *RecurrenceCursor) OffsetDateTime() OffsetDateTime {
+	return OffsetDateTime{v: c.cur, o: c.offset}
+}
+
+// Index returns the index of the current occurrence, counting from 0.
+func (c *RecurrenceCursor) Index() int {
+	return c.n - 1
+}
+
+// Err returns the error, if any, that caused Next to return false before all of the occurrences were returned.
+func (c *RecurrenceCursor) Err() error {
+	return c.err
+}
@@ -0,0 +1,354 @@
+package chrono_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-chrono/chrono"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	for _, tt := range []struct {
+		str      string
+		expected string
+	}{
+		{"FREQ=DAILY;COUNT=10", "FREQ=DAILY;COUNT=10"},
+		{"RRULE:FREQ=WEEKLY;UNTIL=19971007T000000Z;WKST=SU;BYDAY=TU,TH", "FREQ=WEEKLY;UNTIL=19971007T000000Z;BYDAY=TU,TH;WKST=SU"},
+		{"FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1SU,-1SU", "FREQ=MONTHLY;COUNT=10;INTERVAL=2;BYDAY=1SU,-1SU"},
+		{"freq=yearly;until=20000131;bymonth=1;byday=su,mo", "FREQ=YEARLY;UNTIL=20000131;BYDAY=SU,MO;BYMONTH=1"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{"FREQ=DAILY;UNTIL=19971224T090000;BYHOUR=9,10;BYMINUTE=0,20,40;BYSECOND=0", "FREQ=DAILY;UNTIL=19971224T090000;BYSECOND=0;BYMINUTE=0,20,40;BYHOUR=9,10"},
+		{"FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO", "FREQ=YEARLY;BYDAY=MO;BYWEEKNO=20"},
+		{"FREQ=YEARLY;INTERVAL=3;COUNT=10;BYYEARDAY=1,100,200", "FREQ=YEARLY;COUNT=10;INTERVAL=3;BYYEARDAY=1,100,200"},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			r, err := chrono.ParseRecurrenceRule(tt.str)
+			if err != nil {
+				t.Fatalf("failed to parse rule: %v", err)
+			}
+
+			if formatted := r.String(); formatted != tt.expected {
+				t.Errorf("r.String() = %v, want %v", formatted, tt.expected)
+			}
+		})
+	}
+
+	for _, str := range []string{
+		"",
+		"COUNT=10",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;COUNT=10;COUNT=5",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;INTERVAL=-1",
+		"FREQ=DAILY;COUNT=10;UNTIL=19971224T000000Z",
+		"FREQ=DAILY;UNTIL=1997-12-24",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;BYMONTHDAY=0",
+		"FREQ=DAILY;BYMONTH=13",
+		"FREQ=DAILY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYYEARDAY=1",
+		"FREQ=MONTHLY;BYWEEKNO=1",
+		"FREQ=YEARLY;BYWEEKNO=1;BYDAY=1MO",
+		"FREQ=DAILY;BYSETPOS=1",
+		"FREQ=DAILY;WKST=XX",
+		"FREQ=DAILY;BYEASTER=0",
+		"FREQ=DAILY;COUNT",
+	} {
+		t.Run(str, func(t *testing.T) {
+			if _, err := chrono.ParseRecurrenceRule(str); err == nil {
+				t.Errorf("expecting error, got nil")
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_Cursor(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		rule     string
+		start    string
+		exdates  []string
+		rdates   []string
+		limit    int
+		expected string
+	}{
+		{
+			name:     "daily for 10 occurrences",
+			rule:     "FREQ=DAILY;COUNT=10",
+			start:    "19970902T090000",
+			expected: "19970902T090000 19970903T090000 19970904T090000 19970905T090000 19970906T090000 19970907T090000 19970908T090000 19970909T090000 19970910T090000 19970911T090000",
+		},
+		{
+			name:     "every other day",
+			rule:     "FREQ=DAILY;INTERVAL=2",
+			start:    "19970902T090000",
+			limit:    4,
+			expected: "19970902T090000 19970904T090000 19970906T090000 19970908T090000",
+		},
+		{
+			name:     "every 10 days for 5 occurrences",
+			rule:     "FREQ=DAILY;INTERVAL=10;COUNT=5",
+			start:    "19970902T090000",
+			expected: "19970902T090000 19970912T090000 19970922T090000 19971002T090000 19971012T090000",
+		},
+		{
+			name:     "weekly on Tuesday and Thursday until",
+			rule:     "FREQ=WEEKLY;UNTIL=19971007T000000Z;WKST=SU;BYDAY=TU,TH",
+			start:    "19970902T090000",
+			expected: "19970902T090000 19970904T090000 19970909T090000 19970911T090000 19970916T090000 19970918T090000 19970923T090000 19970925T090000 19970930T090000 19971002T090000",
+		},
+		{
+			name:     "every other week on Monday, Wednesday and Friday",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;UNTIL=19971024T000000Z;WKST=SU;BYDAY=MO,WE,FR",
+			start:    "19970901T090000",
+			expected: "19970901T090000 19970903T090000 19970905T090000 19970915T090000 19970917T090000 19970919T090000 19970929T090000 19971001T090000 19971003T090000 19971013T090000 19971015T090000 19971017T090000",
+		},
+		{
+			name:     "week start Monday",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+			start:    "19970805T090000",
+			expected: "19970805T090000 19970810T090000 19970819T090000 19970824T090000",
+		},
+		{
+			name:     "week start Sunday",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			start:    "19970805T090000",
+			expected: "19970805T090000 19970817T090000 19970819T090000 19970831T090000",
+		},
+		{
+			name:     "monthly on the first Friday",
+			rule:     "FREQ=MONTHLY;COUNT=10;BYDAY=1FR",
+			start:    "19970905T090000",
+			expected: "19970905T090000 19971003T090000 19971107T090000 19971205T090000 19980102T090000 19980206T090000 19980306T090000 19980403T090000 19980501T090000 19980605T090000",
+		},
+		{
+			name:     "every other month on the first and last Sunday",
+			rule:     "FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1SU,-1SU",
+			start:    "19970907T090000",
+			expected: "19970907T090000 19970928T090000 19971102T090000 19971130T090000 19980104T090000 19980125T090000 19980301T090000 19980329T090000 19980503T090000 19980531T090000",
+		},
+		{
+			name:     "monthly on the second-to-last Monday",
+			rule:     "FREQ=MONTHLY;COUNT=6;BYDAY=-2MO",
+			start:    "19970922T090000",
+			expected: "19970922T090000 19971020T090000 19971117T090000 19971222T090000 19980119T090000 19980216T090000",
+		},
+		{
+			name:     "monthly on the third-to-last day",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-3",
+			start:    "19970928T090000",
+			limit:    6,
+			expected: "19970928T090000 19971029T090000 19971128T090000 19971229T090000 19980129T090000 19980226T090000",
+		},
+		{
+			name:     "monthly skipping invalid dates",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=15,30;COUNT=5",
+			start:    "20070115T090000",
+			expected: "20070115T090000 20070130T090000 20070215T090000 20070315T090000 20070330T090000",
+		},
+		{
+			name:     "yearly in June and July",
+			rule:     "FREQ=YEARLY;COUNT=10;BYMONTH=6,7",
+			start:    "19970610T090000",
+			expected: "19970610T090000 19970710T090000 19980610T090000 19980710T090000 19990610T090000 19990710T090000 20000610T090000 20000710T090000 20010610T090000 20010710T090000",
+		},
+		{
+			name:     "every third year on the 1st, 100th and 200th day",
+			rule:     "FREQ=YEARLY;INTERVAL=3;COUNT=10;BYYEARDAY=1,100,200",
+			start:    "19970101T090000",
+			expected: "19970101T090000 19970410T090000 19970719T090000 20000101T090000 20000409T090000 20000718T090000 20030101T090000 20030410T090000 20030719T090000 20060101T090000",
+		},
+		{
+			name:     "yearly on the 20th Monday",
+			rule:     "FREQ=YEARLY;BYDAY=20MO",
+			start:    "19970519T090000",
+			limit:    3,
+			expected: "19970519T090000 19980518T090000 19990517T090000",
+		},
+		{
+			name:     "Monday of week number 20",
+			rule:     "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO",
+			start:    "19970512T090000",
+			limit:    3,
+			expected: "19970512T090000 19980511T090000 19990517T090000",
+		},
+		{
+			name:     "week number 20 on the weekday of the start",
+			rule:     "FREQ=YEARLY;BYWEEKNO=20",
+			start:    "19970512T090000",
+			limit:    3,
+			expected: "19970512T090000 19980511T090000 19990517T090000",
+		},
+		{
+			name:     "every Thursday in March",
+			rule:     "FREQ=YEARLY;BYMONTH=3;BYDAY=TH",
+			start:    "19970313T090000",
+			limit:    6,
+			expected: "19970313T090000 19970320T090000 19970327T090000 19980305T090000 19980312T090000 19980319T090000",
+		},
+		{
+			name:     "every Friday the 13th",
+			rule:     "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13",
+			start:    "19970902T090000",
+			exdates:  []string{"19970902T090000"},
+			limit:    5,
+			expected: "19980213T090000 19980313T090000 19981113T090000 19990813T090000 20001013T090000",
+		},
+		{
+			name:     "first Saturday that follows the first Sunday",
+			rule:     "FREQ=MONTHLY;BYDAY=SA;BYMONTHDAY=7,8,9,10,11,12,13",
+			start:    "19970913T090000",
+			limit:    4,
+			expected: "19970913T090000 19971011T090000 19971108T090000 19971213T090000",
+		},
+		{
+			name:     "US presidential election day",
+			rule:     "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8",
+			start:    "19961105T090000",
+			limit:    3,
+			expected: "19961105T090000 20001107T090000 20041102T090000",
+		},
+		{
+			name:     "third instance of Tuesday, Wednesday or Thursday",
+			rule:     "FREQ=MONTHLY;COUNT=3;BYDAY=TU,WE,TH;BYSETPOS=3",
+			start:    "19970904T090000",
+			expected: "19970904T090000 19971007T090000 19971106T090000",
+		},
+		{
+			name:     "last work day of the month",
+			rule:     "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			start:    "19970929T090000",
+			limit:    5,
+			expected: "19970929T090000 19970930T090000 19971031T090000 19971128T090000 19971231T090000",
+		},
+		{
+			name:     "every 3 hours until",
+			rule:     "FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T170000",
+			start:    "19970902T090000",
+			expected: "19970902T090000 19970902T120000 19970902T150000",
+		},
+		{
+			name:     "every 15 minutes for 6 occurrences",
+			rule:     "FREQ=MINUTELY;INTERVAL=15;COUNT=6",
+			start:    "19970902T090000",
+			expected: "19970902T090000 19970902T091500 19970902T093000 19970902T094500 19970902T100000 19970902T101500",
+		},
+		{
+			name:     "every 20 minutes between 9:00 and 16:40 daily",
+			rule:     "FREQ=DAILY;BYHOUR=9,10,11,12,13,14,15,16;BYMINUTE=0,20,40",
+			start:    "19970902T090000",
+			limit:    27,
+			expected: "19970902T090000 19970902T092000 19970902T094000 19970902T100000 19970902T102000 19970902T104000 19970902T110000 19970902T112000 19970902T114000 19970902T120000 19970902T122000 19970902T124000 19970902T130000 19970902T132000 19970902T134000 19970902T140000 19970902T142000 19970902T144000 19970902T150000 19970902T152000 19970902T154000 19970902T160000 19970902T162000 19970902T164000 19970903T090000 19970903T092000 19970903T094000",
+		},
+		{
+			name:     "every 20 minutes between 9:00 and 16:40 minutely",
+			rule:     "FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10,11,12,13,14,15,16",
+			start:    "19970902T160000",
+			limit:    5,
+			expected: "19970902T160000 19970902T162000 19970902T164000 19970903T090000 19970903T092000",
+		},
+		{
+			name:     "secondly limited by day",
+			rule:     "FREQ=SECONDLY;INTERVAL=30;BYDAY=MO;COUNT=4",
+			start:    "20260101T000000",
+			expected: "20260101T000000 20260105T000000 20260105T000030 20260105T000100",
+		},
+		{
+			name:     "until date",
+			rule:     "FREQ=DAILY;UNTIL=19970904",
+			start:    "19970902T090000",
+			expected: "19970902T090000 19970903T090000 19970904T090000",
+		},
+		{
+			name:     "additional and excluded dates",
+			rule:     "FREQ=WEEKLY;COUNT=4",
+			start:    "20260105T090000",
+			rdates:   []string{"20260107T090000", "20260105T090000", "20260102T090000"},
+			exdates:  []string{"20260112T090000"},
+			expected: "20260102T090000 20260105T090000 20260107T090000 20260119T090000 20260126T090000",
+		},
+		{
+			name:     "never matches",
+			rule:     "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			start:    "20260101T090000",
+			expected: "20260101T090000",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := chrono.ParseRecurrenceRule(tt.rule)
+			if err != nil {
+				t.Fatalf("failed to parse rule: %v", err)
+			}
+
+			c, err := r.Cursor(parseRecurrenceDateTime(t, tt.start),
+				parseRecurrenceDateTimes(t, tt.rdates), parseRecurrenceDateTimes(t, tt.exdates))
+			if err != nil {
+				t.Fatalf("r.Cursor() error = %v", err)
+			}
+
+			var out []string
+			for (tt.limit == 0 || len(out) < tt.limit) && c.Next() {
+				if c.Index() != len(out) {
+					t.Errorf("c.Index() = %d, want %d", c.Index(), len(out))
+				}
+				out = append(out, c.LocalDateTime().Format("%Y%m%dT%H%M%S"))
+			}
+
+			if err := c.Err(); err != nil {
+				t.Errorf("c.Err() = %v", err)
+			}
+
+			if actual := strings.Join(out, " "); actual != tt.expected {
+				t.Errorf("occurrences = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_OffsetCursor(t *testing.T) {
+	r, err := chrono.ParseRecurrenceRule("FREQ=DAILY;UNTIL=19970904T130000Z")
+	if err != nil {
+		t.Fatalf("failed to parse rule: %v", err)
+	}
+
+	offset := chrono.OffsetOf(-4, 0)
+	start := chrono.OffsetDateTimeOf(1997, chrono.September, 2, 9, 0, 0, 0, -4, 0)
+	exdate := chrono.OffsetDateTimeOf(1997, chrono.September, 3, 13, 0, 0, 0, 0, 0)
+
+	c, err := r.OffsetCursor(start, nil, []chrono.OffsetDateTime{exdate})
+	if err != nil {
+		t.Fatalf("r.OffsetCursor() error = %v", err)
+	}
+
+	var out []string
+	for c.Next() {
+		if o := c.OffsetDateTime().Offset(); o != offset {
+			t.Errorf("c.OffsetDateTime().Offset() = %v, want %v", o, offset)
+		}
+		out = append(out, c.OffsetDateTime().String())
+	}
+
+	expected := "1997-09-02 09:00:00-04:00 1997-09-04 09:00:00-04:00"
+	if actual := strings.Join(out, " "); actual != expected {
+		t.Errorf("occurrences = %v, want %v", actual, expected)
+	}
+}
+
+func parseRecurrenceDateTime(t *testing.T, s string) chrono.LocalDateTime {
+	var out chrono.LocalDateTime
+	if err := out.Parse("%Y%m%dT%H%M%S", s); err != nil {
+		t.Fatalf("failed to parse date-time: %v", err)
+	}
+	return out
+}
+
+func parseRecurrenceDateTimes(t *testing.T, values []string) []chrono.LocalDateTime {
+	var out []chrono.LocalDateTime
+	for _, s := range values {
+		out = append(out, parseRecurrenceDateTime(t, s))
+	}
+	return out
+}
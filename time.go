@@ -3,6 +3,7 @@ package chrono
 import "fmt"
 
 const (
+	oneDay    = 24 * int64(Hour)
 	oneHour   = int64(Hour)
 	oneMinute = int64(Minute)
 	oneSecond = int64(Second)
@@ -77,3 +78,48 @@ func compareTimes(t, t2 int64) int {
 		return 0
 	}
 }
+
+// roundMode specifies the direction in which a value is rounded to a multiple of a unit.
+type roundMode int
+
+const (
+	roundDown roundMode = iota
+	roundHalfUp
+	roundUp
+)
+
+// roundsUp reports whether a value that is elapsed nanoseconds past the start of a unit of the specified length
+// should be rounded up to the start of the next unit.
+func (m roundMode) roundsUp(elapsed, length int64) bool {
+	switch m {
+	case roundHalfUp:
+		return elapsed >= length-elapsed
+	case roundUp:
+		return elapsed > 0
+	default:
+		return false
+	}
+}
+
+// roundTime rounds t to a multiple of unit, which is at most UnitDay. A result that is rounded up
+// to the end of the day in which t falls wraps around to the start of that day.
+func roundTime(t int64, unit Unit, mode roundMode) (int64, error) {
+	if unit < UnitNanosecond || unit > UnitDay {
+		return 0, fmt.Errorf("unsupported unit %v", unit)
+	}
+
+	length, day := int64(unitExtents[unit-1]), int64(unitExtents[UnitDay-1])
+	out := t - t%length
+	if mode.roundsUp(t%length, length) {
+		out += length
+	}
+
+	if out-(t-t%day) == day {
+		out -= day
+	}
+
+	if out > maxTime {
+		return 0, fmt.Errorf("time out of range")
+	}
+	return out, nil
+}
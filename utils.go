@@ -1,27 +1,18 @@
 package chrono
 
-import "math"
+import (
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
 
 // addInt64 attempts to add v1 to v2 but reports if the operation would underflow or overflow int64.
 func addInt64(v1, v2 int64) (sum int64, underflows, overflows bool) {
-	if v2 > 0 {
-		v := math.MaxInt64 - v1
-		if v < 0 {
-			v = -v
-		}
-
-		if v < v2 {
-			return 0, false, true
-		}
-	} else if v2 < 0 {
-		v := math.MinInt64 + v1
-		if v < 0 {
-			v = -v
-		}
-
-		if -v > v2 { // v < -v2 can't be used because -math.MinInt64 > math.MaxInt64
-			return 0, true, false
-		}
+	if v2 > 0 && v1 > math.MaxInt64-v2 {
+		return 0, false, true
+	} else if v2 < 0 && v1 < math.MinInt64-v2 {
+		return 0, true, false
 	}
 	return v1 + v2, false, false
 }
@@ -34,3 +25,126 @@ func divideAndRoundInt(x, y int) int {
 	}
 	return (x - r) / y
 }
+
+// decimalFraction returns the first n decimal places of the fraction v/unit, truncated, where 0 <= v < unit and n <= 19.
+func decimalFraction(v, unit int64, n uint) int64 {
+	hi, lo := bits.Mul64(uint64(v), pow10(n))
+	q, _ := bits.Div64(hi, lo, uint64(unit))
+	return int64(q)
+}
+
+// parseDecimalFraction returns the product of unit and the decimal fraction represented by digits, truncated.
+// Only the first 19 digits are considered.
+func parseDecimalFraction(digits string, unit int64) int64 {
+	if len(digits) > 19 {
+		digits = digits[:19]
+	}
+
+	var v uint64
+	for _, c := range digits {
+		v = v*10 + uint64(c-'0')
+	}
+
+	hi, lo := bits.Mul64(v, uint64(unit))
+	q, _ := bits.Div64(hi, lo, pow10(uint(len(digits))))
+	return int64(q)
+}
+
+func pow10(n uint) uint64 {
+	out := uint64(1)
+	for ; n > 0; n-- {
+		out *= 10
+	}
+	return out
+}
+
+// uint128 is an unsigned 128-bit integer.
+type uint128 struct {
+	hi, lo uint64
+}
+
+func (u uint128) cmp(v uint128) int {
+	switch {
+	case u.hi < v.hi || (u.hi == v.hi && u.lo < v.lo):
+		return -1
+	case u.hi > v.hi || (u.hi == v.hi && u.lo > v.lo):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// add returns u+v, and reports whether the result overflows.
+func (u uint128) add(v uint128) (uint128, bool) {
+	lo, c := bits.Add64(u.lo, v.lo, 0)
+	hi, c := bits.Add64(u.hi, v.hi, c)
+	return uint128{hi: hi, lo: lo}, c != 0
+}
+
+// sub returns u-v, where v <= u.
+func (u uint128) sub(v uint128) uint128 {
+	lo, b := bits.Sub64(u.lo, v.lo, 0)
+	hi, _ := bits.Sub64(u.hi, v.hi, b)
+	return uint128{hi: hi, lo: lo}
+}
+
+// mul64 returns u*v, and reports whether the result overflows.
+func (u uint128) mul64(v uint64) (uint128, bool) {
+	hi, lo := bits.Mul64(u.lo, v)
+	over, mid := bits.Mul64(u.hi, v)
+	hi, c := bits.Add64(hi, mid, 0)
+	return uint128{hi: hi, lo: lo}, over != 0 || c != 0
+}
+
+// div64 returns the quotient and remainder of u/v, where v != 0.
+func (u uint128) div64(v uint64) (uint128, uint64) {
+	qhi, r := u.hi/v, u.hi%v
+	qlo, r := bits.Div64(r, u.lo, v)
+	return uint128{hi: qhi, lo: qlo}, r
+}
+
+// div returns the quotient and remainder of u/v, where v != 0 and u < 2^127.
+func (u uint128) div(v uint128) (q, r uint128) {
+	if v.hi == 0 {
+		q, r := u.div64(v.lo)
+		return q, uint128{lo: r}
+	}
+
+	for i := 127; i >= 0; i-- {
+		r = uint128{hi: r.hi<<1 | r.lo>>63, lo: r.lo << 1}
+		if i >= 64 {
+			r.lo |= (u.hi >> uint(i-64)) & 1
+		} else {
+			r.lo |= (u.lo >> uint(i)) & 1
+		}
+
+		if r.cmp(v) >= 0 {
+			r = r.sub(v)
+			if i >= 64 {
+				q.hi |= 1 << uint(i-64)
+			} else {
+				q.lo |= 1 << uint(i)
+			}
+		}
+	}
+	return q, r
+}
+
+// String returns u formatted in base 10.
+func (u uint128) String() string {
+	if u.hi == 0 {
+		return strconv.FormatUint(u.lo, 10)
+	}
+
+	q, r := u.div64(1e19)
+	lo := strconv.FormatUint(r, 10)
+	return q.String() + strings.Repeat("0", 19-len(lo)) + lo
+}
+
+// absInt64 returns the absolute value of v, which is representable for all values of v as a uint64.
+func absInt64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-v)
+	}
+	return uint64(v)
+}